@@ -0,0 +1,9 @@
+data "bitwarden-secrets_dotenv" "app" {
+  project_id     = "3f5b1c2e-8d4a-4b7e-9c1f-6a2d0e7b9f14"
+  uppercase_keys = true
+}
+
+resource "local_sensitive_file" "env" {
+  filename = "${path.module}/.env"
+  content  = data.bitwarden-secrets_dotenv.app.dotenv
+}
@@ -0,0 +1,6 @@
+data "bitwarden-secrets_project_access_check" "deployment" {
+  project_ids = [
+    "8f8b6e6d-2c4e-4f5a-9d3b-7e1a0c2b4d6f",
+    "3c2d1e0f-4b5a-4c6d-8e7f-9a0b1c2d3e4f",
+  ]
+}
@@ -0,0 +1,8 @@
+data "bitwarden-secrets_project_secrets" "app" {
+  project_id = "3f5b1c2e-8d4a-4b7e-9c1f-6a2d0e7b9f14"
+}
+
+output "api_key" {
+  value     = data.bitwarden-secrets_project_secrets.app.secrets["API_KEY"].value
+  sensitive = true
+}
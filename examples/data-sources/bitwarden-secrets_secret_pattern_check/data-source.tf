@@ -0,0 +1,5 @@
+data "bitwarden-secrets_secret_pattern_check" "database_url" {
+  secret_id     = "8f8b6e6d-2c4e-4f5a-9d3b-7e1a0c2b4d6f"
+  pattern       = "^postgres://[^:]+:[^@]+@[^/]+/\\w+$"
+  error_message = "The database URL must be a PostgreSQL connection string."
+}
@@ -0,0 +1,15 @@
+data "bitwarden-secrets_secrets_by_id" "shared" {
+  ids = [
+    "3f5b1c2e-8d4a-4b7e-9c1f-6a2d0e7b9f14",
+    "9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d",
+  ]
+}
+
+output "database_password" {
+  value     = data.bitwarden-secrets_secrets_by_id.shared.secrets["3f5b1c2e-8d4a-4b7e-9c1f-6a2d0e7b9f14"].value
+  sensitive = true
+}
+
+output "unreadable_secrets" {
+  value = data.bitwarden-secrets_secrets_by_id.shared.errors
+}
@@ -0,0 +1,16 @@
+data "bitwarden-secrets_secrets_diff" "promotion" {
+  project_id_a = "3f5b1c2e-8d4a-4b7e-9c1f-6a2d0e7b9f14" # staging
+  project_id_b = "a7c9e0d3-52f6-4e18-b4a0-1d8f3c6e2b57" # production
+}
+
+output "only_in_staging" {
+  value = data.bitwarden-secrets_secrets_diff.promotion.only_in_a
+}
+
+output "only_in_production" {
+  value = data.bitwarden-secrets_secrets_diff.promotion.only_in_b
+}
+
+output "differing_values" {
+  value = data.bitwarden-secrets_secrets_diff.promotion.differing_values
+}
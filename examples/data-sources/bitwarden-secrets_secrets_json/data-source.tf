@@ -0,0 +1,9 @@
+data "bitwarden-secrets_secrets_json" "app" {
+  project_id   = "3f5b1c2e-8d4a-4b7e-9c1f-6a2d0e7b9f14"
+  pretty_print = true
+}
+
+resource "local_sensitive_file" "secrets" {
+  filename = "${path.module}/secrets.json"
+  content  = data.bitwarden-secrets_secrets_json.app.json
+}
@@ -0,0 +1,15 @@
+data "bitwarden-secrets_secrets_yaml" "app" {
+  project_id = "3f5b1c2e-8d4a-4b7e-9c1f-6a2d0e7b9f14"
+  wrap_key   = "stringData"
+}
+
+resource "local_sensitive_file" "secret_manifest" {
+  filename = "${path.module}/secret.yaml"
+  content = join("\n", [
+    "apiVersion: v1",
+    "kind: Secret",
+    "metadata:",
+    "  name: app",
+    data.bitwarden-secrets_secrets_yaml.app.yaml,
+  ])
+}
@@ -0,0 +1,13 @@
+resource "bitwarden-secrets_project_secrets" "app" {
+  project_id = "3f5b1c2e-8d4a-4b7e-9c1f-6a2d0e7b9f14"
+
+  secrets = {
+    API_KEY = {
+      value = var.api_key
+    }
+    DATABASE_URL = {
+      value = var.database_url
+      note  = "Primary database of the app"
+    }
+  }
+}
@@ -0,0 +1,6 @@
+resource "bitwarden-secrets_secrets_file" "app" {
+  project_id      = "3f5b1c2e-8d4a-4b7e-9c1f-6a2d0e7b9f14"
+  path            = "${path.module}/rendered/app.env"
+  format          = "env"
+  file_permission = "0600"
+}
@@ -1,8 +1,15 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"sync"
 	"testing"
 )
 
@@ -73,3 +80,99 @@ func TestAccConcurrency(t *testing.T) {
 		},
 	})
 }
+
+// TestConcurrentOperations runs many resource and data source operations in parallel through a single shared client,
+// as Terraform does during a plan or apply. Run it with -race to detect unsynchronized access to shared state.
+func TestConcurrentOperations(t *testing.T) {
+	const workers = 32
+
+	mock := newMockBitwardenClient()
+	project := mock.addProject(mockOrgId, "project")
+	summary := &operationSummary{}
+	client := newMarkerBitwardenClient(newTimingBitwardenClient(context.Background(), mock, true, summary), "managed-by: terraform")
+	cache := newProjectCache()
+
+	r := &secretResource{bitwardenClient: client, organizationId: mockOrgId, summary: summary, validateProjectOrganization: true, projectCache: cache}
+	listSecrets := &listSecretsDataSource{bitwardenClient: client, organizationId: mockOrgId}
+	projects := &projectsDataSource{bitwardenClient: client, organizationId: mockOrgId}
+	schema := secretResourceTestSchema(t)
+	listSecretsSchema := dataSourceTestSchema(t, listSecrets)
+	projectsSchema := dataSourceTestSchema(t, projects)
+	listSecretsConfig := newTestConfig(t, listSecretsSchema, listSecretsDataSourceModel{})
+	projectsConfig := newTestConfig(t, projectsSchema, projectsDataSourceModel{})
+
+	// The plans are built up front, as t.Fatal must not be called from the worker goroutines.
+	plans := make([]tfsdk.Plan, workers)
+	for i := range plans {
+		plans[i] = newTestPlan(t, schema, secretResourceModel{
+			ID:        types.StringUnknown(),
+			Key:       types.StringValue(fmt.Sprintf("key-%d", i)),
+			Value:     types.StringValue("value"),
+			ProjectID: types.StringValue(project.ID),
+		})
+	}
+
+	errs := make(chan error, 3*workers)
+	var wg sync.WaitGroup
+	for i := range workers {
+		wg.Add(3)
+
+		go func() {
+			defer wg.Done()
+			createResp := fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+			newTestPrivateState(&createResp.Private)
+			r.Create(context.Background(), fwresource.CreateRequest{Plan: plans[i]}, &createResp)
+			if createResp.Diagnostics.HasError() {
+				errs <- fmt.Errorf("create %d: %v", i, createResp.Diagnostics)
+				return
+			}
+
+			readResp := fwresource.ReadResponse{State: createResp.State}
+			newTestPrivateState(&readResp.Private)
+			r.Read(context.Background(), fwresource.ReadRequest{State: createResp.State}, &readResp)
+			if readResp.Diagnostics.HasError() {
+				errs <- fmt.Errorf("read %d: %v", i, readResp.Diagnostics)
+				return
+			}
+
+			deleteResp := fwresource.DeleteResponse{State: readResp.State}
+			r.Delete(context.Background(), fwresource.DeleteRequest{State: readResp.State}, &deleteResp)
+			if deleteResp.Diagnostics.HasError() {
+				errs <- fmt.Errorf("delete %d: %v", i, deleteResp.Diagnostics)
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+			resp := datasource.ReadResponse{State: tfsdk.State{Schema: listSecretsSchema}}
+			listSecrets.Read(context.Background(), datasource.ReadRequest{Config: listSecretsConfig}, &resp)
+			if resp.Diagnostics.HasError() {
+				errs <- fmt.Errorf("list secrets %d: %v", i, resp.Diagnostics)
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+			resp := datasource.ReadResponse{State: tfsdk.State{Schema: projectsSchema}}
+			projects.Read(context.Background(), datasource.ReadRequest{Config: projectsConfig}, &resp)
+			if resp.Diagnostics.HasError() {
+				errs <- fmt.Errorf("projects %d: %v", i, resp.Diagnostics)
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+	if len(mock.secrets) != 0 {
+		t.Errorf("expected all secrets to be deleted, got %d", len(mock.secrets))
+	}
+	if count := mock.callCount("Secrets.Create"); count != workers {
+		t.Errorf("expected %d Secrets.Create calls, got %d", workers, count)
+	}
+	if summary.creates != workers || summary.deletes != workers {
+		t.Errorf("expected %d creates and deletes in the summary, got %d and %d", workers, summary.creates, summary.deletes)
+	}
+}
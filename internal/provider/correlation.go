@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const correlationIdField = "correlation_id"
+
+// newCorrelationContext sets up the logging subsystem of the provider with the configured log level for a single
+// resource or data source operation. It generates a correlation ID and adds it to all log entries written with the
+// returned context. The Bitwarden SDK does not support custom headers, so the ID is not sent to the Bitwarden Secrets
+// Manager API.
+func newCorrelationContext(ctx context.Context, logLevel string) (context.Context, string) {
+	correlationId := uuid.NewString()
+	ctx = newLogContext(ctx, logLevel)
+	return tflog.SubsystemSetField(ctx, logSubsystem, correlationIdField, correlationId), correlationId
+}
+
+// appendCorrelationId appends the correlation ID of an operation to the detail of all its error diagnostics, so that
+// failures can be matched with the provider logs. It must be deferred by the method it annotates.
+func appendCorrelationId(diags *diag.Diagnostics, correlationId string) {
+	if !diags.HasError() {
+		return
+	}
+
+	annotated := make(diag.Diagnostics, 0, len(*diags))
+	for _, d := range *diags {
+		if d.Severity() != diag.SeverityError {
+			annotated = append(annotated, d)
+			continue
+		}
+
+		errorDiagnostic := diag.NewErrorDiagnostic(d.Summary(), d.Detail()+"\n\nCorrelation ID: "+correlationId)
+		if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+			annotated = append(annotated, diag.WithPath(withPath.Path(), errorDiagnostic))
+			continue
+		}
+		annotated = append(annotated, errorDiagnostic)
+	}
+	*diags = annotated
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"testing"
+)
+
+func TestAppendCorrelationId(t *testing.T) {
+	var diags diag.Diagnostics
+	diags.AddWarning("warning", "warning detail")
+	diags.AddError("error", "error detail")
+	diags.AddAttributeError(path.Root("key"), "attribute error", "attribute error detail")
+
+	appendCorrelationId(&diags, "correlation")
+
+	if diags[0].Detail() != "warning detail" {
+		t.Errorf("expected warning to be unchanged, got: %q", diags[0].Detail())
+	}
+	if diags[1].Detail() != "error detail\n\nCorrelation ID: correlation" {
+		t.Errorf("expected error to contain the correlation ID, got: %q", diags[1].Detail())
+	}
+	withPath, ok := diags[2].(diag.DiagnosticWithPath)
+	if !ok || !withPath.Path().Equal(path.Root("key")) {
+		t.Errorf("expected attribute error to keep its path, got: %v", diags[2])
+	}
+}
+
+func TestSecretResourceReadErrorContainsCorrelationId(t *testing.T) {
+	r := &secretResource{bitwardenClient: newMockBitwardenClient(), organizationId: mockOrgId}
+	schema := secretResourceTestSchema(t)
+
+	state := newTestState(t, schema, secretResourceModel{ID: types.StringValue(validProjectUUID)})
+	resp := fwresource.ReadResponse{State: state}
+	r.Read(context.Background(), fwresource.ReadRequest{State: state}, &resp)
+
+	if !diagnosticsContain(resp.Diagnostics, "Correlation ID: ") {
+		t.Fatalf("expected diagnostic with correlation ID, got: %v", resp.Diagnostics)
+	}
+}
@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/bitwarden/sdk-go/v2"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ datasource.DataSource              = &dotenvDataSource{}
+	_ datasource.DataSourceWithConfigure = &dotenvDataSource{}
+
+	// unquotedDotenvValuePattern matches values which can be written to a dotenv file without quoting.
+	unquotedDotenvValuePattern = regexp.MustCompile(`^[A-Za-z0-9_./:@%+,=-]*$`)
+	dotenvValueEscaper         = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `$`, `\$`, "`", "\\`", "\n", `\n`, "\r", `\r`)
+)
+
+func NewDotenvDataSource() datasource.DataSource {
+	return &dotenvDataSource{}
+}
+
+// dotenvDataSource defines the data source implementation.
+type dotenvDataSource struct {
+	bitwardenClient sdk.BitwardenClientInterface
+	projectCache    *projectCache
+	organizationId  string
+	verboseErrors   bool
+	logLevel        string
+	redactKeys      bool
+}
+
+type dotenvDataSourceModel struct {
+	ProjectID      types.String `tfsdk:"project_id"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	UppercaseKeys  types.Bool   `tfsdk:"uppercase_keys"`
+	Dotenv         types.String `tfsdk:"dotenv"`
+}
+
+func (d *dotenvDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dotenv"
+}
+
+func (d *dotenvDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "The dotenv data source renders all secrets of a project in the .env format.",
+		MarkdownDescription: "The `dotenv` data source renders all secrets of a project in the `.env` format.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description:         "String representation of the ID of the project whose secrets are rendered.",
+				MarkdownDescription: "String representation of the `ID` of the project whose secrets are rendered.",
+				Required:            true,
+				Validators: []validator.String{
+					stringUUIDValidate(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description:         "String representation of the ID of the organization to which the project belongs. Overrides the organization configured on the provider.",
+				MarkdownDescription: "String representation of the `ID` of the organization to which the project belongs. Overrides the `organization_id` configured on the provider.",
+				Computed:            true,
+				Optional:            true,
+				Validators: []validator.String{
+					stringUUIDValidate(),
+				},
+			},
+			"uppercase_keys": schema.BoolAttribute{
+				Description:         "When set to true, the keys of the secrets are converted to uppercase. The provided default is false.",
+				MarkdownDescription: "When set to `true`, the keys of the secrets are converted to uppercase. The provided default is `false`.",
+				Optional:            true,
+			},
+			"dotenv": schema.StringAttribute{
+				Description: "The secrets of the project as KEY=value lines sorted by key. Values containing special characters are double-quoted and escaped. " +
+					"This attribute is sensitive.",
+				MarkdownDescription: "The secrets of the project as `KEY=value` lines sorted by key. Values containing special characters are double-quoted and escaped. " +
+					"This attribute is sensitive.",
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (d *dotenvDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	providerDataStruct, ok := configureClient(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	ctx = newLogContext(ctx, providerDataStruct.logLevel)
+	tflog.SubsystemInfo(ctx, logSubsystem, "Configuring Dotenv Datasource")
+
+	d.bitwardenClient = providerDataStruct.bitwardenClient
+	d.projectCache = providerDataStruct.projectCache
+	d.organizationId = providerDataStruct.organizationId
+	d.verboseErrors = providerDataStruct.verboseErrors
+	d.logLevel = providerDataStruct.logLevel
+	d.redactKeys = providerDataStruct.redactKeys
+
+	tflog.SubsystemInfo(ctx, logSubsystem, "Datasource Configured")
+}
+
+func (d *dotenvDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, correlationId := newCorrelationContext(ctx, d.logLevel)
+	defer appendCorrelationId(&resp.Diagnostics, correlationId)
+
+	tflog.SubsystemInfo(ctx, logSubsystem, "Reading Dotenv Datasource")
+
+	var state dotenvDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer recoverFromPanic(ctx, "Render Dotenv", state.ProjectID.ValueString(), &resp.Diagnostics)
+
+	if d.bitwardenClient == nil {
+		resp.Diagnostics.AddError(
+			"Client Not Initialized",
+			"The Bitwarden bitwardenClient was not properly initialized.",
+		)
+		return
+	}
+
+	if !checkContext(ctx, "Render Dotenv", &resp.Diagnostics) {
+		return
+	}
+
+	organizationId := resolveOrganizationId(state.OrganizationID, d.organizationId)
+	secrets, err := listProjectSecrets(d.bitwardenClient, d.projectCache, organizationId, state.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Project Secrets",
+			fmt.Sprintf("Unable to read the secrets of the project with id: %s.\n\n%s%s", state.ProjectID.ValueString(), sdkErrorDetail(err, organizationId, d.verboseErrors),
+				projectScopeDetail(d.bitwardenClient, d.projectCache, organizationId, state.ProjectID.ValueString(), err)),
+		)
+		return
+	}
+
+	values, duplicates := secretValuesByKey(secrets, state.UppercaseKeys.ValueBool())
+	addDuplicatedKeysWarning(&resp.Diagnostics, state.ProjectID.ValueString(), duplicates, d.redactKeys)
+
+	state.OrganizationID = types.StringValue(organizationId)
+	state.Dotenv = types.StringValue(renderDotenv(values))
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// renderDotenv renders the given values as KEY=value lines sorted by key.
+func renderDotenv(values map[string]string) string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var builder strings.Builder
+	for _, key := range keys {
+		builder.WriteString(key)
+		builder.WriteString("=")
+		builder.WriteString(quoteDotenvValue(values[key]))
+		builder.WriteString("\n")
+	}
+	return builder.String()
+}
+
+// quoteDotenvValue double-quotes and escapes a value if it contains characters with a special meaning in dotenv files.
+func quoteDotenvValue(value string) string {
+	if unquotedDotenvValuePattern.MatchString(value) {
+		return value
+	}
+	return `"` + dotenvValueEscaper.Replace(value) + `"`
+}
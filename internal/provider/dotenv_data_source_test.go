@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"testing"
+)
+
+func TestQuoteDotenvValue(t *testing.T) {
+	tests := map[string]string{
+		"":                       "",
+		"plain_value-1.2":        "plain_value-1.2",
+		"https://example.com/a":  "https://example.com/a",
+		"with space":             `"with space"`,
+		`say "hi"`:               `"say \"hi\""`,
+		"multi\nline":            `"multi\nline"`,
+		`back\slash`:             `"back\\slash"`,
+		"$HOME":                  `"\$HOME"`,
+		"# not a comment":        `"# not a comment"`,
+		`{"json": ["value", 1]}`: `"{\"json\": [\"value\", 1]}"`,
+	}
+
+	for value, expected := range tests {
+		if quoted := quoteDotenvValue(value); quoted != expected {
+			t.Errorf("expected %q to be quoted as %q, got: %q", value, expected, quoted)
+		}
+	}
+}
+
+func TestDotenvDataSourceRead(t *testing.T) {
+	client := newMockBitwardenClient()
+	project := client.addProject(mockOrgId, "app")
+	otherProject := client.addProject(mockOrgId, "other")
+	client.addSecret("db_password", "p@ss word", "", mockOrgId, project.ID)
+	client.addSecret("API_KEY", "abc123", "", mockOrgId, project.ID)
+	client.addSecret("OTHER", "ignored", "", mockOrgId, otherProject.ID)
+
+	tests := map[string]struct {
+		uppercaseKeys types.Bool
+		expected      string
+	}{
+		"keys as stored": {uppercaseKeys: types.BoolNull(), expected: "API_KEY=abc123\ndb_password=\"p@ss word\"\n"},
+		"uppercase keys": {uppercaseKeys: types.BoolValue(true), expected: "API_KEY=abc123\nDB_PASSWORD=\"p@ss word\"\n"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			d := &dotenvDataSource{bitwardenClient: client, organizationId: mockOrgId}
+			schema := dataSourceTestSchema(t, d)
+			req := datasource.ReadRequest{Config: newTestConfig(t, schema, dotenvDataSourceModel{
+				ProjectID:      types.StringValue(project.ID),
+				OrganizationID: types.StringNull(),
+				UppercaseKeys:  test.uppercaseKeys,
+				Dotenv:         types.StringNull(),
+			})}
+			resp := datasource.ReadResponse{State: tfsdk.State{Schema: schema}}
+			d.Read(context.Background(), req, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var state dotenvDataSourceModel
+			resp.State.Get(context.Background(), &state)
+			if state.Dotenv.ValueString() != test.expected {
+				t.Fatalf("expected dotenv %q, got: %q", test.expected, state.Dotenv.ValueString())
+			}
+		})
+	}
+}
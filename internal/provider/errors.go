@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// accessTokenPattern matches Bitwarden machine account access tokens, which have the format "0.<client id>.<client secret>:<encryption key>".
+var accessTokenPattern = regexp.MustCompile(`0\.[0-9a-fA-F-]{36}\.[A-Za-z0-9]+:[A-Za-z0-9+/=]+`)
+
+// httpStatusPattern matches the HTTP status of an API error in the format of the Bitwarden SDK, e.g. "[404 Not Found]",
+// so that numbers in IDs or other parts of a message are not mistaken for a status.
+var httpStatusPattern = regexp.MustCompile(`\[([1-5][0-9]{2}) [A-Za-z]`)
+
+// httpStatus returns the HTTP status of an API error message returned by the Bitwarden SDK, or 0 if it has none.
+func httpStatus(message string) int {
+	match := httpStatusPattern.FindStringSubmatch(message)
+	if match == nil {
+		return 0
+	}
+	status, _ := strconv.Atoi(match[1])
+	return status
+}
+
+// isNotFoundError reports whether an error message returned by the Bitwarden SDK indicates that the requested
+// object does not exist (anymore).
+func isNotFoundError(message string) bool {
+	return strings.Contains(strings.ToLower(message), "not found")
+}
+
+// isAccessDeniedError reports whether an error message returned by the Bitwarden SDK indicates that the machine account
+// is not permitted to perform the operation.
+func isAccessDeniedError(message string) bool {
+	return httpStatus(message) == 403 || strings.Contains(strings.ToLower(message), "forbidden")
+}
+
+// transientErrorMessages contains lowercase fragments of errors returned by the Bitwarden SDK which are caused by
+// network issues or an overloaded server and are therefore worth retrying.
+var transientErrorMessages = []string{
+	"connection refused",
+	"connection reset",
+	"no such host",
+	"timeout",
+	"timed out",
+	"temporary failure",
+	"unexpected eof",
+	": eof",
+	"[429",
+	"too many requests",
+	"[500",
+	"[502",
+	"bad gateway",
+	"[503",
+	"service unavailable",
+	"[504",
+	"gateway timeout",
+}
+
+// isTransientError reports whether an error message returned by the Bitwarden SDK indicates a transient failure.
+// Authentication failures are not transient.
+func isTransientError(message string) bool {
+	lowerMessage := strings.ToLower(message)
+	for _, fragment := range transientErrorMessages {
+		if strings.Contains(lowerMessage, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+// secretsManagerDisabledMessages contains lowercase fragments of errors returned by the Bitwarden SDK if Secrets Manager
+// is not enabled for the organization.
+var secretsManagerDisabledMessages = []string{
+	"secrets manager is not enabled",
+	"secrets manager is disabled",
+	"does not have access to secrets manager",
+	"organization does not use secrets manager",
+}
+
+// isSecretsManagerDisabledError reports whether an error message returned by the Bitwarden SDK indicates that Secrets
+// Manager is not enabled for the organization.
+func isSecretsManagerDisabledError(message string) bool {
+	lowerMessage := strings.ToLower(message)
+	for _, fragment := range secretsManagerDisabledMessages {
+		if strings.Contains(lowerMessage, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+// classifySdkError returns a user-friendly explanation for well-known errors returned by the Bitwarden SDK.
+// An empty string is returned if the error is not known.
+func classifySdkError(message string, organizationId string) string {
+	lowerMessage := strings.ToLower(message)
+	status := httpStatus(message)
+	switch {
+	case isSecretsManagerDisabledError(message):
+		return fmt.Sprintf("Secrets Manager is not enabled for organization %s. "+
+			"An owner or admin of the organization has to enable Secrets Manager in the organization's subscription settings "+
+			"and the machine account has to belong to that organization.", organizationId)
+	case isNotFoundError(message):
+		return "The requested object does not exist in Bitwarden Secrets Manager or the machine account has no access to it."
+	case status == 401 || strings.Contains(lowerMessage, "unauthorized"):
+		return "The access token of the machine account was rejected. Verify that the access token is valid and has not been revoked."
+	case isAccessDeniedError(message):
+		return "The machine account is not permitted to perform this operation. Verify that it has the required access to the project."
+	case status == 429 || strings.Contains(lowerMessage, "too many requests"):
+		return "The rate limit of the Bitwarden Secrets Manager API was exceeded. Please retry later."
+	case status >= 500 || strings.Contains(lowerMessage, "internal server error") || strings.Contains(lowerMessage, "service unavailable"):
+		return "The Bitwarden Secrets Manager API failed to process the request. Please retry later."
+	}
+	return ""
+}
+
+// redactSdkError removes access tokens and the given sensitive values from an error message returned by the Bitwarden SDK.
+func redactSdkError(message string, sensitiveValues ...string) string {
+	message = accessTokenPattern.ReplaceAllString(message, "<redacted>")
+	for _, value := range sensitiveValues {
+		if value != "" {
+			message = strings.ReplaceAll(message, value, "<redacted>")
+		}
+	}
+	return message
+}
+
+// sdkErrorDetail builds the detail of a diagnostic for an error returned by the Bitwarden SDK. Well-known errors are
+// explained in a user-friendly way and the raw SDK error is only appended if verbose is true. Unknown errors always
+// contain the raw SDK error. The raw SDK error is redacted of access tokens and the given sensitive values.
+func sdkErrorDetail(err error, organizationId string, verbose bool, sensitiveValues ...string) string {
+	rawError := "Bitwarden SDK Error: " + redactSdkError(err.Error(), sensitiveValues...)
+
+	explanation := classifySdkError(err.Error(), organizationId)
+	if explanation == "" {
+		return rawError
+	}
+
+	if !verbose {
+		return explanation
+	}
+
+	return explanation + "\n\n" + rawError
+}
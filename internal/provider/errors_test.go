@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSdkErrorDetail(t *testing.T) {
+	accessToken := "0.9d4b0a0e-2c8a-4f7e-b0a8-3d5c6f7e8a9b.Pq1Zx9yRsT2uVwX3yZ4aBcDeFgHiJk:aGVsbG8gd29ybGQgdGhpcyBpcyBhIGtleQ=="
+
+	tests := map[string]struct {
+		err             error
+		verbose         bool
+		sensitiveValues []string
+		contains        []string
+		notContains     []string
+	}{
+		"known error without raw error": {
+			err:         errors.New("API error: [404 Not Found] Resource not found"),
+			contains:    []string{"does not exist"},
+			notContains: []string{"Bitwarden SDK Error", "404"},
+		},
+		"known error with raw error": {
+			err:      errors.New("API error: [404 Not Found] Resource not found"),
+			verbose:  true,
+			contains: []string{"does not exist", "Bitwarden SDK Error: API error: [404 Not Found] Resource not found"},
+		},
+		"secrets manager disabled": {
+			err:         errors.New("API error: [400 Bad Request] Organization does not have access to Secrets Manager"),
+			contains:    []string{"Secrets Manager is not enabled for organization " + mockOrgId},
+			notContains: []string{"Bitwarden SDK Error"},
+		},
+		"unauthorized status": {
+			err:         errors.New("API error: [401 Unauthorized]"),
+			contains:    []string{"access token of the machine account was rejected"},
+			notContains: []string{"Bitwarden SDK Error"},
+		},
+		"server error status": {
+			err:         errors.New("API error: [502 Bad Gateway]"),
+			contains:    []string{"failed to process the request"},
+			notContains: []string{"Bitwarden SDK Error"},
+		},
+		"status digits in an id": {
+			err:         errors.New("API error: [400 Bad Request] project 401a429b-5c2d-4e0f-8a1b-2c3d4e5f6a7b is invalid"),
+			contains:    []string{"Bitwarden SDK Error: API error: [400 Bad Request] project 401a429b"},
+			notContains: []string{"access token", "rate limit", "failed to process"},
+		},
+		"bracketed id starting with 5": {
+			err:         errors.New("invalid secret ids [5f3a4010-4290-4c1d-9e2f-3a4b5c6d7e8f]"),
+			contains:    []string{"Bitwarden SDK Error: invalid secret ids"},
+			notContains: []string{"access token", "rate limit", "failed to process"},
+		},
+		"unknown error always contains raw error": {
+			err:      errors.New("something unexpected happened"),
+			contains: []string{"Bitwarden SDK Error: something unexpected happened"},
+		},
+		"access token is redacted": {
+			err:         errors.New("invalid token " + accessToken),
+			verbose:     true,
+			contains:    []string{"invalid token <redacted>"},
+			notContains: []string{accessToken},
+		},
+		"sensitive value is redacted": {
+			err:             errors.New("API error: [400 Bad Request] value super-secret is invalid"),
+			verbose:         true,
+			sensitiveValues: []string{"", "super-secret"},
+			contains:        []string{"value <redacted> is invalid"},
+			notContains:     []string{"super-secret"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			detail := sdkErrorDetail(test.err, mockOrgId, test.verbose, test.sensitiveValues...)
+			for _, expected := range test.contains {
+				if !strings.Contains(detail, expected) {
+					t.Errorf("expected detail to contain %q, got: %q", expected, detail)
+				}
+			}
+			for _, unexpected := range test.notContains {
+				if strings.Contains(detail, unexpected) {
+					t.Errorf("expected detail not to contain %q, got: %q", unexpected, detail)
+				}
+			}
+		})
+	}
+}
+
+func TestErrorPredicates(t *testing.T) {
+	tests := map[string]struct {
+		message      string
+		accessDenied bool
+		transient    bool
+	}{
+		"forbidden status":       {message: "API error: [403 Forbidden]", accessDenied: true},
+		"forbidden reason":       {message: "access forbidden", accessDenied: true},
+		"status digits in an id": {message: "API error: [400 Bad Request] secret 4030b1c2-d3e4-4f50-8a6b-7c8d9e0f1a2b is invalid"},
+		"unexpected eof":         {message: "error sending request: unexpected EOF", transient: true},
+		"eof of the connection":  {message: "connection closed: EOF", transient: true},
+		"eof inside a word":      {message: "API error: [400 Bad Request] key GEOFENCE is invalid"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if accessDenied := isAccessDeniedError(test.message); accessDenied != test.accessDenied {
+				t.Errorf("expected access denied to be %t, got: %t", test.accessDenied, accessDenied)
+			}
+			if transient := isTransientError(test.message); transient != test.transient {
+				t.Errorf("expected transient to be %t, got: %t", test.transient, transient)
+			}
+		})
+	}
+}
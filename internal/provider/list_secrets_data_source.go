@@ -1,12 +1,20 @@
 package provider
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"sync"
 
 	"github.com/bitwarden/sdk-go/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -25,15 +33,24 @@ func NewListSecretsDataSource() datasource.DataSource {
 type listSecretsDataSource struct {
 	bitwardenClient sdk.BitwardenClientInterface
 	organizationId  string
+	verboseErrors   bool
+	logLevel        string
 }
 
 type listSecretsDataSourceModel struct {
-	Secrets []listSecretDataSourceModel `tfsdk:"secrets"`
+	OrganizationID      types.String                `tfsdk:"organization_id"`
+	ProjectIDs          []types.String              `tfsdk:"project_ids"`
+	IncludeValues       types.Bool                  `tfsdk:"include_values"`
+	Parallelism         types.Int64                 `tfsdk:"parallelism"`
+	PreserveServerOrder types.Bool                  `tfsdk:"preserve_server_order"`
+	Secrets             []listSecretDataSourceModel `tfsdk:"secrets"`
 }
 
 type listSecretDataSourceModel struct {
-	ID  types.String `tfsdk:"id"`
-	Key types.String `tfsdk:"key"`
+	ID        types.String `tfsdk:"id"`
+	Key       types.String `tfsdk:"key"`
+	ProjectID types.String `tfsdk:"project_id"`
+	Value     types.String `tfsdk:"value"`
 }
 
 func (l *listSecretsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -45,6 +62,46 @@ func (l *listSecretsDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 		Description:         "The list_secrets data source fetches all secrets accessible by the used machine account.",
 		MarkdownDescription: "The `list_secrets` data source fetches all secrets accessible by the used machine account.",
 		Attributes: map[string]schema.Attribute{
+			"organization_id": schema.StringAttribute{
+				Description:         "String representation of the ID of the organization from which the secrets are listed. Overrides the organization configured on the provider.",
+				MarkdownDescription: "String representation of the `ID` of the organization from which the secrets are listed. Overrides the `organization_id` configured on the provider.",
+				Computed:            true,
+				Optional:            true,
+				Validators: []validator.String{
+					stringUUIDValidate(),
+				},
+			},
+			"project_ids": schema.ListAttribute{
+				Description:         "List of project IDs to which the listed secrets are restricted. The union of the secrets of all projects is returned and every secret is only listed once.",
+				MarkdownDescription: "List of project `IDs` to which the listed secrets are restricted. The union of the secrets of all projects is returned and every secret is only listed once.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringUUIDValidate()),
+				},
+			},
+			"include_values": schema.BoolAttribute{
+				Description:         "When set to true, the values of the listed secrets are fetched as well. The provided default is false.",
+				MarkdownDescription: "When set to `true`, the values of the listed secrets are fetched as well. The provided default is `false`.",
+				Optional:            true,
+			},
+			"parallelism": schema.Int64Attribute{
+				Description: fmt.Sprintf("The number of concurrent requests in which the values are fetched if include_values is set. "+
+					"The secrets are split into this many batches of equal size. The provided default is 1, which fetches all values in a single request, and the maximum is %d.", maxListParallelism),
+				MarkdownDescription: fmt.Sprintf("The number of concurrent requests in which the values are fetched if `include_values` is set. "+
+					"The secrets are split into this many batches of equal size. The provided default is `1`, which fetches all values in a single request, and the maximum is `%d`.", maxListParallelism),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, maxListParallelism),
+				},
+			},
+			"preserve_server_order": schema.BoolAttribute{
+				Description: "When set to true, the secrets are returned in the order of the Bitwarden Secrets Manager API, which may change between refreshes. " +
+					"Otherwise, the secrets are sorted by key and then by ID. The provided default is false.",
+				MarkdownDescription: "When set to `true`, the secrets are returned in the order of the Bitwarden Secrets Manager API, which may change between refreshes. " +
+					"Otherwise, the secrets are sorted by `key` and then by `ID`. The provided default is `false`.",
+				Optional: true,
+			},
 			"secrets": schema.ListNestedAttribute{
 				Description: "Nested list of all fetched secrets",
 				Computed:    true,
@@ -60,6 +117,17 @@ func (l *listSecretsDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 							MarkdownDescription: "String representation of the `key` of the secret. Inside Bitwarden Secrets Manager this is called \"name\".",
 							Computed:            true,
 						},
+						"project_id": schema.StringAttribute{
+							Description:         "String representation of the ID of the project from which the secret was listed.",
+							MarkdownDescription: "String representation of the `ID` of the project from which the secret was listed.",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							Description:         "String representation of the value of the secret. Only set if include_values is true. This attribute is sensitive.",
+							MarkdownDescription: "String representation of the `value` of the secret. Only set if `include_values` is true. This attribute is sensitive.",
+							Computed:            true,
+							Sensitive:           true,
+						},
 					},
 				},
 			},
@@ -68,83 +136,202 @@ func (l *listSecretsDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 }
 
 func (l *listSecretsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	// Add a nil check when handling BitwardenSecretsManagerProviderDataStruct because Terraform
-	// sets that data after it calls the ConfigureProvider RPC.
-	tflog.Info(ctx, "Configuring List Secrets Datasource")
-	if req.ProviderData == nil {
-		tflog.Debug(ctx, "Skipping Datasource Configuration because Provider has not been configured yet.")
+	providerDataStruct, ok := configureClient(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
 		return
 	}
+	ctx = newLogContext(ctx, providerDataStruct.logLevel)
+	tflog.SubsystemInfo(ctx, logSubsystem, "Configuring List Secrets Datasource")
 
-	providerDataStruct, ok := req.ProviderData.(BitwardenSecretsManagerProviderDataStruct)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *sdk.BitwardenClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
+	l.bitwardenClient = providerDataStruct.bitwardenClient
+	l.organizationId = providerDataStruct.organizationId
+	l.verboseErrors = providerDataStruct.verboseErrors
+	l.logLevel = providerDataStruct.logLevel
+
+	tflog.SubsystemInfo(ctx, logSubsystem, "Datasource Configured")
+}
 
+func (l *listSecretsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, correlationId := newCorrelationContext(ctx, l.logLevel)
+	defer appendCorrelationId(&resp.Diagnostics, correlationId)
+
+	tflog.SubsystemInfo(ctx, logSubsystem, "Reading List Secrets Datasource")
+
+	var state listSecretsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	client := providerDataStruct.bitwardenClient
-	organizationId := providerDataStruct.organizationId
+	organizationId := resolveOrganizationId(state.OrganizationID, l.organizationId)
+	defer recoverFromPanic(ctx, "List Secrets", organizationId, &resp.Diagnostics)
 
-	if client == nil {
+	if l.bitwardenClient == nil {
 		resp.Diagnostics.AddError(
 			"Client Not Initialized",
-			"The Bitwarden bitwardenClient was not properly initialized due to a missing Bitwarden API Client.",
+			"The Bitwarden bitwardenClient was not properly initialized.",
 		)
 		return
 	}
 
-	if organizationId == "" {
-		resp.Diagnostics.AddError(
-			"Client Not Initialized",
-			"The Bitwarden bitwardenClient was not properly initialized due to an empty Organization ID.",
-		)
+	if !checkContext(ctx, "List Secrets", &resp.Diagnostics) {
 		return
 	}
 
-	l.bitwardenClient = client
-	l.organizationId = organizationId
-
-	tflog.Info(ctx, "Datasource Configured")
-}
-
-func (l *listSecretsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
-	tflog.Info(ctx, "Reading List Secrets Datasource")
-
-	var state listSecretsDataSourceModel
-
-	if l.bitwardenClient == nil {
+	secrets, err := l.bitwardenClient.Secrets().List(organizationId)
+	if err != nil {
 		resp.Diagnostics.AddError(
-			"Client Not Initialized",
-			"The Bitwarden bitwardenClient was not properly initialized.",
+			"Unable to List Secrets",
+			sdkErrorDetail(err, organizationId, l.verboseErrors),
 		)
 		return
 	}
 
-	secrets, err := l.bitwardenClient.Secrets().List(l.organizationId)
-	if err != nil {
+	if secrets == nil {
 		resp.Diagnostics.AddError(
-			"Unable to List Secrets",
-			err.Error(),
+			"Unexpected Bitwarden Secrets Manager Response",
+			"The Bitwarden Secrets Manager API returned an empty response when listing secrets.",
 		)
 		return
 	}
 
+	state.OrganizationID = types.StringValue(organizationId)
+	var secretIds []string
 	for _, secret := range secrets.Data {
+		projectId, ok := sourceProjectId(secret.ProjectIDS, state.ProjectIDs)
+		if !ok || slices.Contains(secretIds, secret.ID) {
+			continue
+		}
+
+		secretIds = append(secretIds, secret.ID)
 		secretState := listSecretDataSourceModel{
-			ID:  types.StringValue(secret.ID),
-			Key: types.StringValue(secret.Key),
+			ID:        types.StringValue(secret.ID),
+			Key:       types.StringValue(secret.Key),
+			ProjectID: types.StringNull(),
+			Value:     types.StringNull(),
+		}
+		if projectId != "" {
+			secretState.ProjectID = types.StringValue(projectId)
 		}
 		state.Secrets = append(state.Secrets, secretState)
 	}
 
+	if !state.PreserveServerOrder.ValueBool() {
+		slices.SortStableFunc(state.Secrets, func(a, b listSecretDataSourceModel) int {
+			return cmp.Or(strings.Compare(a.Key.ValueString(), b.Key.ValueString()), strings.Compare(a.ID.ValueString(), b.ID.ValueString()))
+		})
+	}
+
+	if state.IncludeValues.ValueBool() && len(secretIds) > 0 {
+		parallelism := max(state.Parallelism.ValueInt64(), 1)
+		values, err := fetchSecretValues(ctx, l.bitwardenClient, secretIds, int(parallelism))
+		if errors.Is(err, errEmptyResponse) {
+			resp.Diagnostics.AddError(
+				"Unexpected Bitwarden Secrets Manager Response",
+				"The Bitwarden Secrets Manager API returned an empty response when fetching secret values.",
+			)
+			return
+		}
+		var unexpected *unexpectedResponseError
+		if errors.As(err, &unexpected) {
+			resp.Diagnostics.AddError(
+				"Unexpected Bitwarden Secrets Manager Response",
+				err.Error(),
+			)
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Secret Values",
+				sdkErrorDetail(err, organizationId, l.verboseErrors),
+			)
+			return
+		}
+		tflog.SubsystemDebug(ctx, logSubsystem, "Fetched secret values", map[string]any{"secrets": len(secretIds), "parallelism": parallelism})
+
+		for i := range state.Secrets {
+			if value, ok := values[state.Secrets[i].ID.ValueString()]; ok {
+				state.Secrets[i].Value = types.StringValue(value)
+			}
+		}
+	}
+
 	// Set state
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
+
+// maxListParallelism caps the parallelism of list_secrets, so that a single data source cannot flood the API.
+const maxListParallelism = 16
+
+// errEmptyResponse reports an empty response of the Bitwarden Secrets Manager API.
+var errEmptyResponse = errors.New("the Bitwarden Secrets Manager API returned an empty response")
+
+// fetchSecretValues fetches the values of the given secrets keyed by their ID. The secrets are split into parallelism
+// batches which are fetched concurrently, and the values are associated by ID, so the order in which the batches
+// complete does not matter. The first error in the order of the batches is returned. The batches run outside of the
+// recoverFromPanic of the caller, so a panic of a batch is recovered by the batch and returned as its error.
+func fetchSecretValues(ctx context.Context, client sdk.BitwardenClientInterface, secretIds []string, parallelism int) (map[string]string, error) {
+	batchSize := (len(secretIds) + parallelism - 1) / parallelism
+	batches := slices.Collect(slices.Chunk(secretIds, max(batchSize, 1)))
+	responses := make([]*sdk.SecretsResponse, len(batches))
+	errs := make([]error, len(batches))
+
+	var wg sync.WaitGroup
+	for i, batch := range batches {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					tflog.SubsystemError(ctx, logSubsystem, "Recovered from panic while fetching secret values", map[string]any{"secrets": len(batch), "panic": fmt.Sprint(r)})
+					errs[i] = &unexpectedResponseError{err: fmt.Errorf("The provider recovered from an unexpected error while fetching the values of %d secrets. "+
+						"This is most likely caused by a malformed response of the Bitwarden Secrets Manager API. "+
+						"Please report this issue to the provider developers.\n\nError: %v", len(batch), r)}
+				}
+			}()
+			responses[i], errs[i] = client.Secrets().GetByIDS(batch)
+			if errs[i] == nil && responses[i] == nil {
+				errs[i] = errEmptyResponse
+			}
+		}()
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	values := make(map[string]string, len(secretIds))
+	for _, response := range responses {
+		for _, secret := range response.Data {
+			values[secret.ID] = secret.Value
+		}
+	}
+	return values, nil
+}
+
+// sourceProjectId returns the project from which a secret is listed. Without requested projects this is the first
+// project of the secret, otherwise the first requested project the secret belongs to. It returns false if the secret
+// belongs to none of the requested projects.
+func sourceProjectId(secretProjectIds []string, requestedProjectIds []types.String) (string, bool) {
+	if len(requestedProjectIds) == 0 {
+		if len(secretProjectIds) == 0 {
+			return "", true
+		}
+		return secretProjectIds[0], true
+	}
+
+	for _, requestedProjectId := range requestedProjectIds {
+		if slices.Contains(secretProjectIds, requestedProjectId.ValueString()) {
+			return requestedProjectId.ValueString(), true
+		}
+	}
+	return "", false
+}
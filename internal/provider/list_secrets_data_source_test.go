@@ -1,11 +1,19 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"github.com/bitwarden/sdk-go/v2"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"slices"
 	"strconv"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestAccDatasourceListSecretsZeroSecretsMachineAccountWithNoAccess(t *testing.T) {
@@ -158,3 +166,284 @@ func testAccCheckIfSecretExistsInOutput(secretId, secretKey string) resource.Tes
 		return fmt.Errorf("secret with the ID: %s does not exist\n", secretId)
 	}
 }
+
+func TestListSecretsDataSourceReadWithEmptyResponse(t *testing.T) {
+	client := newMockBitwardenClient()
+	client.secretListHook = func(organizationID string) (*sdk.SecretIdentifiersResponse, error) {
+		return nil, nil
+	}
+	d := &listSecretsDataSource{bitwardenClient: client, organizationId: mockOrgId}
+	schema := dataSourceTestSchema(t, d)
+
+	req := datasource.ReadRequest{Config: newTestConfig(t, schema, listSecretsDataSourceModel{})}
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: schema}}
+	d.Read(context.Background(), req, &resp)
+
+	if !diagnosticsContain(resp.Diagnostics, "Unexpected Bitwarden Secrets Manager Response") {
+		t.Fatalf("expected diagnostic about unexpected response, got: %v", resp.Diagnostics)
+	}
+}
+
+func TestListSecretsDataSourceOrganizationOverride(t *testing.T) {
+	client := newMockBitwardenClient()
+	defaultSecret := client.addSecret("default-key", "value", "", mockOrgId, validProjectUUID)
+	overrideSecret := client.addSecret("override-key", "value", "", validProjectUUID, validProjectUUID)
+	d := &listSecretsDataSource{bitwardenClient: client, organizationId: mockOrgId}
+	schema := dataSourceTestSchema(t, d)
+
+	tests := map[string]struct {
+		organizationId types.String
+		expectedId     string
+	}{
+		"provider default": {organizationId: types.StringNull(), expectedId: defaultSecret.ID},
+		"override":         {organizationId: types.StringValue(validProjectUUID), expectedId: overrideSecret.ID},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := datasource.ReadRequest{Config: newTestConfig(t, schema, listSecretsDataSourceModel{OrganizationID: test.organizationId})}
+			resp := datasource.ReadResponse{State: tfsdk.State{Schema: schema}}
+			d.Read(context.Background(), req, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var state listSecretsDataSourceModel
+			resp.State.Get(context.Background(), &state)
+			if len(state.Secrets) != 1 || state.Secrets[0].ID.ValueString() != test.expectedId {
+				t.Fatalf("expected only secret %s, got: %v", test.expectedId, state.Secrets)
+			}
+		})
+	}
+}
+
+func TestListSecretsDataSourceMultipleProjects(t *testing.T) {
+	client := newMockBitwardenClient()
+	projectA := client.addProject(mockOrgId, "a")
+	projectB := client.addProject(mockOrgId, "b")
+	projectC := client.addProject(mockOrgId, "c")
+	secretA := client.addSecret("A", "value-a", "", mockOrgId, projectA.ID)
+	secretB := client.addSecret("B", "value-b", "", mockOrgId, projectB.ID)
+	client.addSecret("C", "value-c", "", mockOrgId, projectC.ID)
+
+	tests := map[string]struct {
+		includeValues  types.Bool
+		expectedValues map[string]types.String
+	}{
+		"without values": {
+			includeValues:  types.BoolNull(),
+			expectedValues: map[string]types.String{secretA.ID: types.StringNull(), secretB.ID: types.StringNull()},
+		},
+		"with values": {
+			includeValues:  types.BoolValue(true),
+			expectedValues: map[string]types.String{secretA.ID: types.StringValue("value-a"), secretB.ID: types.StringValue("value-b")},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			d := &listSecretsDataSource{bitwardenClient: client, organizationId: mockOrgId}
+			schema := dataSourceTestSchema(t, d)
+			req := datasource.ReadRequest{Config: newTestConfig(t, schema, listSecretsDataSourceModel{
+				OrganizationID: types.StringNull(),
+				ProjectIDs:     []types.String{types.StringValue(projectA.ID), types.StringValue(projectB.ID), types.StringValue(projectA.ID)},
+				IncludeValues:  test.includeValues,
+			})}
+			resp := datasource.ReadResponse{State: tfsdk.State{Schema: schema}}
+			d.Read(context.Background(), req, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var state listSecretsDataSourceModel
+			resp.State.Get(context.Background(), &state)
+			if len(state.Secrets) != len(test.expectedValues) {
+				t.Fatalf("expected %d secrets, got: %v", len(test.expectedValues), state.Secrets)
+			}
+			for _, secret := range state.Secrets {
+				expectedProjectId := projectA.ID
+				if secret.ID.ValueString() == secretB.ID {
+					expectedProjectId = projectB.ID
+				}
+				if secret.ProjectID.ValueString() != expectedProjectId {
+					t.Errorf("expected secret %s to be tagged with project %s, got: %s", secret.ID, expectedProjectId, secret.ProjectID)
+				}
+				if !secret.Value.Equal(test.expectedValues[secret.ID.ValueString()]) {
+					t.Errorf("unexpected value for secret %s: %s", secret.ID, secret.Value)
+				}
+			}
+		})
+	}
+}
+
+func TestListSecretsDataSourceSortsSecrets(t *testing.T) {
+	client := newMockBitwardenClient()
+	secretB := client.addSecret("B", "value", "", mockOrgId, validProjectUUID)
+	secretA1 := client.addSecret("A", "value", "", mockOrgId, validProjectUUID)
+	secretA2 := client.addSecret("A", "value", "", mockOrgId, validProjectUUID)
+	serverOrder := []string{secretB.ID, secretA2.ID, secretA1.ID}
+	client.secretListHook = func(organizationID string) (*sdk.SecretIdentifiersResponse, error) {
+		response := sdk.SecretIdentifiersResponse{}
+		for _, id := range serverOrder {
+			secret := client.secrets[id]
+			response.Data = append(response.Data, sdk.SecretIdentifierResponse{ID: secret.ID, Key: secret.Key, OrganizationID: secret.OrganizationID})
+		}
+		return &response, nil
+	}
+
+	sortedIds := []string{secretA1.ID, secretA2.ID}
+	if secretA2.ID < secretA1.ID {
+		sortedIds = []string{secretA2.ID, secretA1.ID}
+	}
+	sortedIds = append(sortedIds, secretB.ID)
+
+	tests := map[string]struct {
+		preserveServerOrder types.Bool
+		expectedIds         []string
+	}{
+		"sorted by key then id": {preserveServerOrder: types.BoolNull(), expectedIds: sortedIds},
+		"server order":          {preserveServerOrder: types.BoolValue(true), expectedIds: serverOrder},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			d := &listSecretsDataSource{bitwardenClient: client, organizationId: mockOrgId}
+			schema := dataSourceTestSchema(t, d)
+
+			resp := datasource.ReadResponse{State: tfsdk.State{Schema: schema}}
+			d.Read(context.Background(), datasource.ReadRequest{Config: newTestConfig(t, schema, listSecretsDataSourceModel{
+				OrganizationID:      types.StringNull(),
+				PreserveServerOrder: test.preserveServerOrder,
+			})}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var state listSecretsDataSourceModel
+			resp.State.Get(context.Background(), &state)
+			var ids []string
+			for _, secret := range state.Secrets {
+				ids = append(ids, secret.ID.ValueString())
+			}
+			if !slices.Equal(ids, test.expectedIds) {
+				t.Errorf("expected secrets %v, got: %v", test.expectedIds, ids)
+			}
+		})
+	}
+}
+
+func TestListSecretsDataSourceParallelism(t *testing.T) {
+	client := newMockBitwardenClient()
+	var secrets []sdk.SecretResponse
+	for i := range 7 {
+		secrets = append(secrets, client.addSecret(fmt.Sprintf("KEY_%d", i), fmt.Sprintf("value-%d", i), "", mockOrgId, validProjectUUID))
+	}
+	// The response of a batch is delayed by the position of its first secret, so that the batches complete in
+	// reverse order.
+	var mu sync.Mutex
+	var batches [][]string
+	client.secretGetByIdsHook = func(secretIDs []string) (*sdk.SecretsResponse, error) {
+		mu.Lock()
+		batches = append(batches, secretIDs)
+		mu.Unlock()
+		position := slices.IndexFunc(secrets, func(secret sdk.SecretResponse) bool { return secret.ID == secretIDs[0] })
+		time.Sleep(time.Duration(len(secrets)-position) * 10 * time.Millisecond)
+		response := sdk.SecretsResponse{}
+		for _, id := range secretIDs {
+			response.Data = append(response.Data, client.secrets[id])
+		}
+		return &response, nil
+	}
+
+	tests := map[string]struct {
+		parallelism     types.Int64
+		expectedBatches int
+	}{
+		"default":                   {parallelism: types.Int64Null(), expectedBatches: 1},
+		"three batches":             {parallelism: types.Int64Value(3), expectedBatches: 3},
+		"more batches than secrets": {parallelism: types.Int64Value(16), expectedBatches: 7},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			batches = nil
+			d := &listSecretsDataSource{bitwardenClient: client, organizationId: mockOrgId}
+			schema := dataSourceTestSchema(t, d)
+			resp := datasource.ReadResponse{State: tfsdk.State{Schema: schema}}
+			d.Read(context.Background(), datasource.ReadRequest{Config: newTestConfig(t, schema, listSecretsDataSourceModel{
+				OrganizationID: types.StringNull(),
+				IncludeValues:  types.BoolValue(true),
+				Parallelism:    test.parallelism,
+			})}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if len(batches) != test.expectedBatches {
+				t.Fatalf("expected %d batches, got: %v", test.expectedBatches, batches)
+			}
+
+			var state listSecretsDataSourceModel
+			resp.State.Get(context.Background(), &state)
+			if len(state.Secrets) != len(secrets) {
+				t.Fatalf("expected %d secrets, got: %v", len(secrets), state.Secrets)
+			}
+			for _, secret := range state.Secrets {
+				if expected := client.secrets[secret.ID.ValueString()].Value; secret.Value.ValueString() != expected {
+					t.Errorf("expected the value %q for secret %s, got: %s", expected, secret.ID, secret.Value)
+				}
+			}
+		})
+	}
+}
+
+func TestListSecretsDataSourceParallelismReportsFirstError(t *testing.T) {
+	client := newMockBitwardenClient()
+	first := client.addSecret("A", "value", "", mockOrgId, validProjectUUID)
+	client.addSecret("B", "value", "", mockOrgId, validProjectUUID)
+	client.secretGetByIdsHook = func(secretIDs []string) (*sdk.SecretsResponse, error) {
+		if secretIDs[0] == first.ID {
+			return nil, fmt.Errorf("API error: [403 Forbidden] first batch")
+		}
+		return nil, fmt.Errorf("API error: [403 Forbidden] second batch")
+	}
+
+	values, err := fetchSecretValues(context.Background(), client, []string{first.ID, "other"}, 2)
+	if err == nil || err.Error() != "API error: [403 Forbidden] first batch" {
+		t.Fatalf("expected the error of the first batch, got: %v and %v", values, err)
+	}
+}
+
+func TestListSecretsDataSourceParallelismRecoversFromPanic(t *testing.T) {
+	client := newMockBitwardenClient()
+	first := client.addSecret("A", "value", "", mockOrgId, validProjectUUID)
+	client.addSecret("B", "value", "", mockOrgId, validProjectUUID)
+	failFirst := false
+	client.secretGetByIdsHook = func(secretIDs []string) (*sdk.SecretsResponse, error) {
+		if secretIDs[0] != first.ID {
+			panic("malformed response")
+		}
+		if failFirst {
+			return nil, fmt.Errorf("API error: [403 Forbidden] first batch")
+		}
+		return &sdk.SecretsResponse{Data: []sdk.SecretResponse{client.secrets[first.ID]}}, nil
+	}
+
+	d := &listSecretsDataSource{bitwardenClient: client, organizationId: mockOrgId}
+	schema := dataSourceTestSchema(t, d)
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: schema}}
+	d.Read(context.Background(), datasource.ReadRequest{Config: newTestConfig(t, schema, listSecretsDataSourceModel{
+		OrganizationID: types.StringNull(),
+		IncludeValues:  types.BoolValue(true),
+		Parallelism:    types.Int64Value(2),
+	})}, &resp)
+	if !diagnosticsContain(resp.Diagnostics, "Unexpected Bitwarden Secrets Manager Response") {
+		t.Fatalf("expected the panic to be reported as a diagnostic, got: %v", resp.Diagnostics)
+	}
+
+	// The error of an earlier batch is still reported first.
+	failFirst = true
+	_, err := fetchSecretValues(context.Background(), client, []string{first.ID, "other"}, 2)
+	if err == nil || err.Error() != "API error: [403 Forbidden] first batch" {
+		t.Fatalf("expected the error of the first batch, got: %v", err)
+	}
+}
@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// logSubsystem is the logging subsystem of all log entries written by the provider during an operation. Its level can
+// be configured with the log_level attribute of the provider independently of TF_LOG.
+const logSubsystem = "bitwarden_secrets"
+
+// logLevels maps the values of the log_level attribute of the provider to the levels of the logging subsystem.
+var logLevels = map[string]hclog.Level{
+	"trace": hclog.Trace,
+	"debug": hclog.Debug,
+	"info":  hclog.Info,
+	"warn":  hclog.Warn,
+}
+
+// newLogContext adds the logging subsystem of the provider to the context. If logLevel is empty, the subsystem uses
+// the level of the provider logs configured by Terraform.
+func newLogContext(ctx context.Context, logLevel string) context.Context {
+	if level, ok := logLevels[logLevel]; ok {
+		return tflog.NewSubsystem(ctx, logSubsystem, tflog.WithLevel(level), tflog.WithRootFields())
+	}
+	return tflog.NewSubsystem(ctx, logSubsystem, tflog.WithRootFields())
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"testing"
+)
+
+func TestNewLogContextLevel(t *testing.T) {
+	tests := []struct {
+		logLevel string
+		expected []string
+	}{
+		{"", []string{"debug", "info", "warn"}},
+		{"trace", []string{"debug", "info", "warn"}},
+		{"info", []string{"info", "warn"}},
+		{"warn", []string{"warn"}},
+	}
+
+	for _, test := range tests {
+		var output bytes.Buffer
+		ctx := newLogContext(tflogtest.RootLogger(context.Background(), &output), test.logLevel)
+
+		tflog.SubsystemDebug(ctx, logSubsystem, "debug")
+		tflog.SubsystemInfo(ctx, logSubsystem, "info")
+		tflog.SubsystemWarn(ctx, logSubsystem, "warn")
+
+		entries, err := tflogtest.MultilineJSONDecode(&output)
+		if err != nil {
+			t.Fatalf("unable to decode log output: %v", err)
+		}
+
+		if len(entries) != len(test.expected) {
+			t.Fatalf("expected %d log entries with log_level %q, got: %v", len(test.expected), test.logLevel, entries)
+		}
+		for i, entry := range entries {
+			if entry["@message"] != test.expected[i] || entry["@module"] != "provider."+logSubsystem {
+				t.Errorf("expected %s log entry of the %s subsystem with log_level %q, got: %v", test.expected[i], logSubsystem, test.logLevel, entry)
+			}
+		}
+	}
+}
+
+func TestConfigureRespectsLogLevel(t *testing.T) {
+	for _, logLevel := range []string{"", "warn"} {
+		var output bytes.Buffer
+		ctx := tflogtest.RootLogger(context.Background(), &output)
+		providerData := BitwardenSecretsManagerProviderDataStruct{bitwardenClient: newMockBitwardenClient(), organizationId: mockOrgId, logLevel: logLevel}
+
+		NewSecretResource().(fwresource.ResourceWithConfigure).Configure(ctx, fwresource.ConfigureRequest{ProviderData: providerData}, &fwresource.ConfigureResponse{})
+		NewDotenvDataSource().(datasource.DataSourceWithConfigure).Configure(ctx, datasource.ConfigureRequest{ProviderData: providerData}, &datasource.ConfigureResponse{})
+
+		entries, err := tflogtest.MultilineJSONDecode(&output)
+		if err != nil {
+			t.Fatalf("unable to decode log output: %v", err)
+		}
+		if expected := map[string]int{"": 4, "warn": 0}[logLevel]; len(entries) != expected {
+			t.Fatalf("expected %d log entries with log_level %q, got: %v", expected, logLevel, entries)
+		}
+		for _, entry := range entries {
+			if entry["@module"] != "provider."+logSubsystem {
+				t.Errorf("expected log entries of the %s subsystem, got: %v", logSubsystem, entry)
+			}
+		}
+	}
+}
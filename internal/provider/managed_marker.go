@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"strings"
+	"time"
+
+	"github.com/bitwarden/sdk-go/v2"
+)
+
+var (
+	// Ensure the marker client types fully satisfy the Bitwarden SDK interfaces.
+	_ sdk.BitwardenClientInterface = &markerBitwardenClient{}
+	_ sdk.SecretsInterface         = &markerSecrets{}
+)
+
+// markerBitwardenClient wraps a Bitwarden client and stamps the note of every secret created or updated by the provider
+// with the managed_marker, so that external audits can identify secrets managed by Terraform. The marker is the first
+// line of the note and is stripped from every secret read, so it never shows up in the Terraform state or in plans.
+type markerBitwardenClient struct {
+	sdk.BitwardenClientInterface
+	marker string
+}
+
+func newMarkerBitwardenClient(client sdk.BitwardenClientInterface, marker string) sdk.BitwardenClientInterface {
+	return &markerBitwardenClient{BitwardenClientInterface: client, marker: marker}
+}
+
+func (c *markerBitwardenClient) Secrets() sdk.SecretsInterface {
+	return &markerSecrets{SecretsInterface: c.BitwardenClientInterface.Secrets(), marker: c.marker}
+}
+
+type markerSecrets struct {
+	sdk.SecretsInterface
+	marker string
+}
+
+func (s *markerSecrets) Create(key, value, note string, organizationID string, projectIDs []string) (*sdk.SecretResponse, error) {
+	secret, err := s.SecretsInterface.Create(key, value, markNote(s.marker, note), organizationID, projectIDs)
+	s.strip(secret)
+	return secret, err
+}
+
+func (s *markerSecrets) Get(secretID string) (*sdk.SecretResponse, error) {
+	secret, err := s.SecretsInterface.Get(secretID)
+	s.strip(secret)
+	return secret, err
+}
+
+func (s *markerSecrets) GetByIDS(secretIDs []string) (*sdk.SecretsResponse, error) {
+	secrets, err := s.SecretsInterface.GetByIDS(secretIDs)
+	if secrets != nil {
+		for i := range secrets.Data {
+			s.strip(&secrets.Data[i])
+		}
+	}
+	return secrets, err
+}
+
+func (s *markerSecrets) Update(secretID string, key, value, note string, organizationID string, projectIDs []string) (*sdk.SecretResponse, error) {
+	secret, err := s.SecretsInterface.Update(secretID, key, value, markNote(s.marker, note), organizationID, projectIDs)
+	s.strip(secret)
+	return secret, err
+}
+
+func (s *markerSecrets) Sync(organizationID string, lastSyncedDate *time.Time) (*sdk.SecretsSyncResponse, error) {
+	response, err := s.SecretsInterface.Sync(organizationID, lastSyncedDate)
+	if response != nil {
+		for i := range response.Secrets {
+			s.strip(&response.Secrets[i])
+		}
+	}
+	return response, err
+}
+
+func (s *markerSecrets) strip(secret *sdk.SecretResponse) {
+	if secret != nil {
+		secret.Note = stripNoteMarker(s.marker, secret.Note)
+	}
+}
+
+// markNote prefixes the note with the marker as its own line. Notes which are already marked are not marked again.
+func markNote(marker string, note string) string {
+	if note == "" {
+		return marker
+	}
+	return marker + "\n" + stripNoteMarker(marker, note)
+}
+
+// stripNoteMarker removes the marker line which was added by markNote from the note.
+func stripNoteMarker(marker string, note string) string {
+	if note == marker {
+		return ""
+	}
+	return strings.TrimPrefix(note, marker+"\n")
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestMarkerBitwardenClient(t *testing.T) {
+	mock := newMockBitwardenClient()
+	client := newMarkerBitwardenClient(mock, "managed-by-terraform")
+
+	created, err := client.Secrets().Create("KEY", "value", "rotated yearly", mockOrgId, []string{validProjectUUID})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stored := mock.secrets[created.ID].Note; stored != "managed-by-terraform\nrotated yearly" {
+		t.Errorf("expected the stored note to be marked, got: %q", stored)
+	}
+	if created.Note != "rotated yearly" {
+		t.Errorf("expected the marker to be stripped from the response, got: %q", created.Note)
+	}
+
+	updated, err := client.Secrets().Update(created.ID, "KEY", "value", "", mockOrgId, []string{validProjectUUID})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stored := mock.secrets[created.ID].Note; stored != "managed-by-terraform" || updated.Note != "" {
+		t.Errorf("expected an empty note to be marked, got: %q and %q", stored, updated.Note)
+	}
+
+	unmarked := mock.addSecret("OTHER", "value", "managed-by-terraform is not a prefix line", mockOrgId, validProjectUUID)
+	secrets, err := client.Secrets().GetByIDS([]string{created.ID, unmarked.ID})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, secret := range secrets.Data {
+		expected := map[string]string{created.ID: "", unmarked.ID: unmarked.Note}[secret.ID]
+		if secret.Note != expected {
+			t.Errorf("expected the note %q for %s, got: %q", expected, secret.Key, secret.Note)
+		}
+	}
+}
+
+func TestMarkNote(t *testing.T) {
+	if note := markNote("marker", "marker\nnote"); note != "marker\nnote" {
+		t.Errorf("expected a marked note not to be marked again, got: %q", note)
+	}
+	if note := stripNoteMarker("marker", "markers\nnote"); note != "markers\nnote" {
+		t.Errorf("expected only the marker line to be stripped, got: %q", note)
+	}
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+)
+
+// defaultProfileFile is the path of the profile file relative to the home directory of the user.
+const defaultProfileFile = ".bws/config"
+
+// providerProfile holds the provider settings of a named profile. Every setting is optional.
+type providerProfile struct {
+	ApiUrl         string `toml:"api_url"`
+	IdentityUrl    string `toml:"identity_url"`
+	AccessToken    string `toml:"access_token"`
+	OrganizationId string `toml:"organization_id"`
+}
+
+// profileFile describes the TOML profile file, in which every profile is a [profiles.<name>] table.
+type profileFile struct {
+	Profiles map[string]providerProfile `toml:"profiles"`
+}
+
+// readProfile reads the named profile from the given profile file, or from ~/.bws/config if no file is given.
+// Unknown settings of the profile are rejected, so that typos do not silently fall back to other configuration sources.
+func readProfile(file string, name string) (providerProfile, error) {
+	if file == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return providerProfile{}, fmt.Errorf("unable to determine the home directory for the default profile file: %w", err)
+		}
+		file = filepath.Join(home, defaultProfileFile)
+	}
+
+	var profiles profileFile
+	metadata, err := toml.DecodeFile(file, &profiles)
+	if errors.Is(err, os.ErrNotExist) {
+		return providerProfile{}, fmt.Errorf("the profile file %s does not exist", file)
+	}
+	if err != nil {
+		return providerProfile{}, fmt.Errorf("unable to parse the profile file %s: %w", file, err)
+	}
+
+	profile, ok := profiles.Profiles[name]
+	if !ok {
+		names := make([]string, 0, len(profiles.Profiles))
+		for profileName := range profiles.Profiles {
+			names = append(names, profileName)
+		}
+		sort.Strings(names)
+		return providerProfile{}, fmt.Errorf("the profile %q does not exist in the profile file %s. Available profiles: %s", name, file, strings.Join(names, ", "))
+	}
+
+	// Only the settings of the used profile are verified, so that the file can be shared with other tools.
+	for _, key := range metadata.Undecoded() {
+		if len(key) > 2 && key[0] == "profiles" && key[1] == name {
+			return providerProfile{}, fmt.Errorf("the profile %q in the profile file %s contains the unknown setting %q", name, file, key[2])
+		}
+	}
+
+	return profile, nil
+}
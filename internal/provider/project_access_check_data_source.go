@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bitwarden/sdk-go/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ datasource.DataSource              = &projectAccessCheckDataSource{}
+	_ datasource.DataSourceWithConfigure = &projectAccessCheckDataSource{}
+)
+
+func NewProjectAccessCheckDataSource() datasource.DataSource {
+	return &projectAccessCheckDataSource{}
+}
+
+// projectAccessCheckDataSource defines the data source implementation. It verifies upfront that the machine account can
+// access a list of projects, so that missing permissions fail the plan instead of the apply.
+type projectAccessCheckDataSource struct {
+	bitwardenClient sdk.BitwardenClientInterface
+	projectCache    *projectCache
+	organizationId  string
+	verboseErrors   bool
+	logLevel        string
+}
+
+type projectAccessCheckDataSourceModel struct {
+	ProjectIDs           []types.String `tfsdk:"project_ids"`
+	OrganizationID       types.String   `tfsdk:"organization_id"`
+	AccessibleProjectIDs []types.String `tfsdk:"accessible_project_ids"`
+}
+
+func (d *projectAccessCheckDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_access_check"
+}
+
+func (d *projectAccessCheckDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The project_access_check data source verifies that the machine account can access every listed project of the organization, " +
+			"and fails the plan with a single error listing every inaccessible project. The Bitwarden SDK does not expose permissions, " +
+			"so read access is verified, while missing write access is only detected by the apply.",
+		MarkdownDescription: "The `project_access_check` data source verifies that the machine account can access every listed project of the organization, " +
+			"and fails the plan with a single error listing every inaccessible project. The Bitwarden SDK does not expose permissions, " +
+			"so read access is verified, while missing write access is only detected by the apply.",
+		Attributes: map[string]schema.Attribute{
+			"project_ids": schema.ListAttribute{
+				Description:         "List of the IDs of the projects which are verified.",
+				MarkdownDescription: "List of the `IDs` of the projects which are verified.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringUUIDValidate()),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description:         "String representation of the ID of the organization to which the projects belong. Overrides the organization configured on the provider.",
+				MarkdownDescription: "String representation of the `ID` of the organization to which the projects belong. Overrides the `organization_id` configured on the provider.",
+				Computed:            true,
+				Optional:            true,
+				Validators: []validator.String{
+					stringUUIDValidate(),
+				},
+			},
+			"accessible_project_ids": schema.ListAttribute{
+				Description:         "List of the IDs of the verified projects, in the order of project_ids. It is only set if all projects are accessible.",
+				MarkdownDescription: "List of the `IDs` of the verified projects, in the order of `project_ids`. It is only set if all projects are accessible.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *projectAccessCheckDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	providerDataStruct, ok := configureClient(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	ctx = newLogContext(ctx, providerDataStruct.logLevel)
+	tflog.SubsystemInfo(ctx, logSubsystem, "Configuring Project Access Check Datasource")
+
+	d.bitwardenClient = providerDataStruct.bitwardenClient
+	d.projectCache = providerDataStruct.projectCache
+	d.organizationId = providerDataStruct.organizationId
+	d.verboseErrors = providerDataStruct.verboseErrors
+	d.logLevel = providerDataStruct.logLevel
+
+	tflog.SubsystemInfo(ctx, logSubsystem, "Datasource Configured")
+}
+
+func (d *projectAccessCheckDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, correlationId := newCorrelationContext(ctx, d.logLevel)
+	defer appendCorrelationId(&resp.Diagnostics, correlationId)
+
+	tflog.SubsystemInfo(ctx, logSubsystem, "Reading Project Access Check Datasource")
+
+	var state projectAccessCheckDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer recoverFromPanic(ctx, "Check Project Access", "", &resp.Diagnostics)
+
+	if d.bitwardenClient == nil {
+		resp.Diagnostics.AddError(
+			"Client Not Initialized",
+			"The Bitwarden client was not properly initialized.",
+		)
+		return
+	}
+
+	if !checkContext(ctx, "Check Project Access", &resp.Diagnostics) {
+		return
+	}
+
+	// Every project is verified, so that all missing permissions are reported at once. With enable_project_cache, the
+	// projects are resolved from a single listing.
+	organizationId := resolveOrganizationId(state.OrganizationID, d.organizationId)
+	var failures []string
+	for _, projectId := range state.ProjectIDs {
+		project, err := d.projectCache.get(d.bitwardenClient, organizationId, projectId.ValueString())
+		switch {
+		case err != nil:
+			failures = append(failures, fmt.Sprintf("- %s: the project does not exist or the machine account has no access to it: %s",
+				projectId.ValueString(), sdkErrorDetail(err, organizationId, d.verboseErrors)))
+		case project == nil:
+			failures = append(failures, fmt.Sprintf("- %s: the Bitwarden Secrets Manager API returned an empty response", projectId.ValueString()))
+		case project.OrganizationID != organizationId:
+			failures = append(failures, fmt.Sprintf("- %s: the project belongs to the organization with id: %s instead of the configured organization",
+				projectId.ValueString(), project.OrganizationID))
+		}
+	}
+	tflog.SubsystemDebug(ctx, logSubsystem, "Checked project access", map[string]any{
+		"projects":     len(state.ProjectIDs),
+		"inaccessible": len(failures),
+	})
+
+	if len(failures) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("project_ids"),
+			"Projects Not Accessible",
+			fmt.Sprintf("The machine account cannot access %d of %d projects in the organization with id: %s:\n\n%s",
+				len(failures), len(state.ProjectIDs), organizationId, strings.Join(failures, "\n")),
+		)
+		return
+	}
+
+	state.OrganizationID = types.StringValue(organizationId)
+	state.AccessibleProjectIDs = state.ProjectIDs
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
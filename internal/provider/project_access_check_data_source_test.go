@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+	"testing"
+)
+
+func TestProjectAccessCheckDataSourceRead(t *testing.T) {
+	client := newMockBitwardenClient()
+	first := client.addProject(mockOrgId, "first")
+	second := client.addProject(mockOrgId, "second")
+	foreign := client.addProject(validProjectUUID, "foreign")
+	missing := "0b5a8a0e-7d3f-4c8e-9e1a-2f6b4c8d0e1f"
+
+	d := &projectAccessCheckDataSource{bitwardenClient: client, organizationId: mockOrgId}
+	schema := dataSourceTestSchema(t, d)
+
+	tests := map[string]struct {
+		projectIds   []string
+		inaccessible []string
+	}{
+		"all accessible":    {projectIds: []string{first.ID, second.ID}},
+		"some inaccessible": {projectIds: []string{first.ID, missing, second.ID, foreign.ID}, inaccessible: []string{missing, foreign.ID}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var config projectAccessCheckDataSourceModel
+			for _, id := range test.projectIds {
+				config.ProjectIDs = append(config.ProjectIDs, types.StringValue(id))
+			}
+			resp := datasource.ReadResponse{State: tfsdk.State{Schema: schema}}
+			d.Read(context.Background(), datasource.ReadRequest{Config: newTestConfig(t, schema, config)}, &resp)
+
+			if len(test.inaccessible) == 0 {
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("unexpected error: %v", resp.Diagnostics)
+				}
+				var state projectAccessCheckDataSourceModel
+				resp.State.Get(context.Background(), &state)
+				if len(state.AccessibleProjectIDs) != len(test.projectIds) {
+					t.Fatalf("expected all projects to be accessible, got: %v", state.AccessibleProjectIDs)
+				}
+				return
+			}
+
+			// All inaccessible projects are reported in a single error.
+			errors := resp.Diagnostics.Errors()
+			if len(errors) != 1 || errors[0].Summary() != "Projects Not Accessible" {
+				t.Fatalf("expected a single error, got: %v", resp.Diagnostics)
+			}
+			for _, id := range test.projectIds {
+				reported := strings.Contains(errors[0].Detail(), id)
+				if expected := strings.Contains(strings.Join(test.inaccessible, " "), id); reported != expected {
+					t.Errorf("expected the project %s to be reported to be %t, got: %s", id, expected, errors[0].Detail())
+				}
+			}
+		})
+	}
+}
+
+func TestProjectAccessCheckDataSourceProjectCacheAndOrganization(t *testing.T) {
+	client := newMockBitwardenClient()
+	otherOrgId := validProjectUUID
+	first := client.addProject(otherOrgId, "first")
+	second := client.addProject(otherOrgId, "second")
+
+	d := &projectAccessCheckDataSource{bitwardenClient: client, projectCache: newProjectCache(), organizationId: mockOrgId}
+	schema := dataSourceTestSchema(t, d)
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: schema}}
+	d.Read(context.Background(), datasource.ReadRequest{Config: newTestConfig(t, schema, projectAccessCheckDataSourceModel{
+		ProjectIDs:     []types.String{types.StringValue(first.ID), types.StringValue(second.ID)},
+		OrganizationID: types.StringValue(otherOrgId),
+	})}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var state projectAccessCheckDataSourceModel
+	resp.State.Get(context.Background(), &state)
+	if state.OrganizationID.ValueString() != otherOrgId || len(state.AccessibleProjectIDs) != 2 {
+		t.Fatalf("expected both projects of the overridden organization to be accessible, got: %+v", state)
+	}
+	if lists, gets := client.callCount("Projects.List"), client.callCount("Projects.Get"); lists != 1 || gets != 0 {
+		t.Fatalf("expected the projects to be resolved from a single listing, got %d listings and %d reads", lists, gets)
+	}
+}
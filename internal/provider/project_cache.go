@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"sync"
+
+	"github.com/bitwarden/sdk-go/v2"
+)
+
+// projectCache shares a single listing of the projects of an organization between all project reads of a provider
+// instance if enable_project_cache is set. Projects which are not listed, e.g. because they were created after the
+// listing, are read individually. The cache lives as long as the provider instance, which Terraform starts for every
+// plan and apply. All methods read directly from the client on a nil cache.
+type projectCache struct {
+	mu       sync.Mutex
+	projects map[string][]sdk.ProjectResponse
+}
+
+func newProjectCache() *projectCache {
+	return &projectCache{projects: map[string][]sdk.ProjectResponse{}}
+}
+
+// list returns the projects of the organization. The projects are only listed by the first call for an organization.
+// Failed listings are not cached.
+func (c *projectCache) list(client sdk.BitwardenClientInterface, organizationId string) ([]sdk.ProjectResponse, error) {
+	if c == nil {
+		return listProjects(client, organizationId)
+	}
+
+	// The lock is held while listing, so that concurrent reads wait for the first listing instead of listing again.
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if projects, ok := c.projects[organizationId]; ok {
+		return projects, nil
+	}
+
+	projects, err := listProjects(client, organizationId)
+	if err != nil {
+		return nil, err
+	}
+	c.projects[organizationId] = projects
+	return projects, nil
+}
+
+// get returns the project with the given ID from the listed projects of the organization, or reads it individually if
+// it is not listed or the projects cannot be listed.
+func (c *projectCache) get(client sdk.BitwardenClientInterface, organizationId string, projectId string) (*sdk.ProjectResponse, error) {
+	if c != nil {
+		projects, err := c.list(client, organizationId)
+		if err == nil {
+			for _, project := range projects {
+				if project.ID == projectId {
+					return &project, nil
+				}
+			}
+		}
+	}
+	return client.Projects().Get(projectId)
+}
+
+// add adds a project created by the provider to the listed projects of its organization, if they were listed already.
+func (c *projectCache) add(project sdk.ProjectResponse) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if projects, ok := c.projects[project.OrganizationID]; ok {
+		c.projects[project.OrganizationID] = append(projects, project)
+	}
+}
+
+// listProjects lists the projects of the organization and treats an empty response as no projects.
+func listProjects(client sdk.BitwardenClientInterface, organizationId string) ([]sdk.ProjectResponse, error) {
+	response, err := client.Projects().List(organizationId)
+	if err != nil || response == nil {
+		return nil, err
+	}
+	return response.Data, nil
+}
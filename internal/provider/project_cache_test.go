@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/bitwarden/sdk-go/v2"
+	"testing"
+)
+
+func TestProjectCacheGetHit(t *testing.T) {
+	client := newMockBitwardenClient()
+	first := client.addProject(mockOrgId, "first")
+	second := client.addProject(mockOrgId, "second")
+	cache := newProjectCache()
+
+	for _, id := range []string{first.ID, second.ID, first.ID} {
+		project, err := cache.get(client, mockOrgId, id)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if project.ID != id {
+			t.Errorf("expected project %s, got %s", id, project.ID)
+		}
+	}
+
+	if count := client.callCount("Projects.List"); count != 1 {
+		t.Errorf("expected 1 Projects.List call, got %d", count)
+	}
+	if count := client.callCount("Projects.Get"); count != 0 {
+		t.Errorf("expected no Projects.Get calls, got %d", count)
+	}
+}
+
+func TestProjectCacheGetMiss(t *testing.T) {
+	client := newMockBitwardenClient()
+	cache := newProjectCache()
+	if _, err := cache.list(client, mockOrgId); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The project is created after the listing and is therefore read individually.
+	created := client.addProject(mockOrgId, "created")
+	project, err := cache.get(client, mockOrgId, created.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if project.ID != created.ID {
+		t.Errorf("expected project %s, got %s", created.ID, project.ID)
+	}
+
+	if _, err := cache.get(client, mockOrgId, validProjectUUID); err == nil {
+		t.Error("expected an error for an unknown project")
+	}
+
+	if count := client.callCount("Projects.List"); count != 1 {
+		t.Errorf("expected 1 Projects.List call, got %d", count)
+	}
+	if count := client.callCount("Projects.Get"); count != 2 {
+		t.Errorf("expected 2 Projects.Get calls, got %d", count)
+	}
+}
+
+func TestProjectCacheListError(t *testing.T) {
+	client := newMockBitwardenClient()
+	project := client.addProject(mockOrgId, "project")
+	client.projectListHook = func(organizationID string) (*sdk.ProjectsResponse, error) {
+		return nil, fmt.Errorf("API error: [500 Internal Server Error]")
+	}
+	cache := newProjectCache()
+
+	for range 2 {
+		if _, err := cache.get(client, mockOrgId, project.ID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	// Failed listings are not cached, so every read lists again before it falls back to a single read.
+	if count := client.callCount("Projects.List"); count != 2 {
+		t.Errorf("expected 2 Projects.List calls, got %d", count)
+	}
+	if count := client.callCount("Projects.Get"); count != 2 {
+		t.Errorf("expected 2 Projects.Get calls, got %d", count)
+	}
+}
+
+func TestProjectCacheAdd(t *testing.T) {
+	client := newMockBitwardenClient()
+	cache := newProjectCache()
+
+	// Projects of organizations which were not listed yet are not added, as the listing will contain them.
+	cache.add(sdk.ProjectResponse{ID: validProjectUUID, OrganizationID: mockOrgId})
+	projects, err := cache.list(client, mockOrgId)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(projects) != 0 {
+		t.Fatalf("expected no projects, got %d", len(projects))
+	}
+
+	cache.add(sdk.ProjectResponse{ID: validProjectUUID, OrganizationID: mockOrgId})
+	if _, err := cache.get(client, mockOrgId, validProjectUUID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if count := client.callCount("Projects.Get"); count != 0 {
+		t.Errorf("expected no Projects.Get calls, got %d", count)
+	}
+}
+
+func TestProjectCacheNil(t *testing.T) {
+	client := newMockBitwardenClient()
+	project := client.addProject(mockOrgId, "project")
+	var cache *projectCache
+
+	for range 2 {
+		if _, err := cache.get(client, mockOrgId, project.ID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := cache.list(client, mockOrgId); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	cache.add(project)
+
+	if count := client.callCount("Projects.List"); count != 2 {
+		t.Errorf("expected 2 Projects.List calls, got %d", count)
+	}
+	if count := client.callCount("Projects.Get"); count != 2 {
+		t.Errorf("expected 2 Projects.Get calls, got %d", count)
+	}
+}
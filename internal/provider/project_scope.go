@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/bitwarden/sdk-go/v2"
+)
+
+// errProjectNotVisible is returned if a project is not listed for the machine account, e.g. because it is outside the
+// scope of the access token. Its message is classified as a not found error.
+var errProjectNotVisible = errors.New("project not found among the projects visible to the machine account")
+
+// maxListedProjects limits the number of accessible projects which are listed in a diagnostic.
+const maxListedProjects = 20
+
+// projectScopeDetail explains a not found or access denied error of a request which targets a project outside the
+// scope of the access token. Machine accounts only see the projects they were granted access to, so the Bitwarden
+// Secrets Manager API reports any other project like a project which does not exist. The explanation lists the
+// projects the machine account can access, if they can be listed. An empty string is returned for other errors and if
+// the machine account can access the project, in which case the error is not caused by the scope of the access token.
+func projectScopeDetail(client sdk.BitwardenClientInterface, cache *projectCache, organizationId string, projectId string, err error) string {
+	if err == nil || !(isNotFoundError(err.Error()) || isAccessDeniedError(err.Error())) {
+		return ""
+	}
+
+	projects, listErr := cache.list(client, organizationId)
+	if listErr != nil {
+		return fmt.Sprintf("\n\nThe access token of the machine account is likely not scoped to the project with id: %s. "+
+			"The projects which the machine account can access could not be listed.", projectId)
+	}
+	if slices.ContainsFunc(projects, func(project sdk.ProjectResponse) bool { return project.ID == projectId }) {
+		return ""
+	}
+
+	detail := fmt.Sprintf("\n\nThe access token of the machine account is not scoped to the project with id: %s. "+
+		"Grant the machine account access to the project or target a project it can access.", projectId)
+	if len(projects) == 0 {
+		return detail + fmt.Sprintf(" The machine account cannot access any project in the organization with id: %s.", organizationId)
+	}
+
+	projects = slices.SortedFunc(slices.Values(projects), func(a, b sdk.ProjectResponse) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.ID, b.ID))
+	})
+	lines := make([]string, 0, min(len(projects), maxListedProjects)+1)
+	for _, project := range projects[:min(len(projects), maxListedProjects)] {
+		lines = append(lines, fmt.Sprintf("- %s (id: %s)", project.Name, project.ID))
+	}
+	if len(projects) > maxListedProjects {
+		lines = append(lines, fmt.Sprintf("- and %d more", len(projects)-maxListedProjects))
+	}
+	return detail + fmt.Sprintf(" The machine account can access %d projects in the organization with id: %s:\n%s",
+		len(projects), organizationId, strings.Join(lines, "\n"))
+}
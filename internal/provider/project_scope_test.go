@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/bitwarden/sdk-go/v2"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+	"testing"
+)
+
+func TestProjectScopeDetail(t *testing.T) {
+	notFound := errors.New("API error: [404 Not Found] Resource not found")
+	outOfScope := "0b5a8a0e-7d3f-4c8e-9e1a-2f6b4c8d0e1f"
+
+	tests := map[string]struct {
+		projects    int
+		listErr     error
+		projectId   string
+		err         error
+		contains    []string
+		notContains []string
+	}{
+		"other error":        {projects: 1, projectId: outOfScope, err: errors.New("API error: [400 Bad Request] invalid key")},
+		"accessible project": {projects: 1, err: notFound},
+		"project out of scope": {
+			projects:  2,
+			projectId: outOfScope,
+			err:       notFound,
+			contains:  []string{"not scoped to the project with id: " + outOfScope, "can access 2 projects", "- project-00 (id: ", "- project-01 (id: "},
+		},
+		"no accessible project": {
+			projectId: outOfScope,
+			err:       errors.New("API error: [403 Forbidden]"),
+			contains:  []string{"cannot access any project in the organization with id: " + mockOrgId},
+		},
+		"many accessible projects": {
+			projects:    maxListedProjects + 2,
+			projectId:   outOfScope,
+			err:         notFound,
+			contains:    []string{fmt.Sprintf("can access %d projects", maxListedProjects+2), "- and 2 more"},
+			notContains: []string{fmt.Sprintf("project-%d ", maxListedProjects)},
+		},
+		"projects cannot be listed": {
+			listErr:     errors.New("API error: [403 Forbidden]"),
+			projectId:   outOfScope,
+			err:         notFound,
+			contains:    []string{"likely not scoped to the project with id: " + outOfScope, "could not be listed"},
+			notContains: []string{"- "},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := newMockBitwardenClient()
+			var accessible sdk.ProjectResponse
+			for i := range test.projects {
+				accessible = client.addProject(mockOrgId, fmt.Sprintf("project-%02d", i))
+			}
+			if test.listErr != nil {
+				client.projectListHook = func(organizationID string) (*sdk.ProjectsResponse, error) {
+					return nil, test.listErr
+				}
+			}
+			projectId := test.projectId
+			if projectId == "" {
+				projectId = accessible.ID
+			}
+
+			detail := projectScopeDetail(client, nil, mockOrgId, projectId, test.err)
+			if len(test.contains) == 0 && detail != "" {
+				t.Fatalf("expected no detail, got: %q", detail)
+			}
+			for _, expected := range test.contains {
+				if !strings.Contains(detail, expected) {
+					t.Errorf("expected detail to contain %q, got: %q", expected, detail)
+				}
+			}
+			for _, unexpected := range test.notContains {
+				if strings.Contains(detail, unexpected) {
+					t.Errorf("expected detail not to contain %q, got: %q", unexpected, detail)
+				}
+			}
+		})
+	}
+}
+
+func TestSecretResourceCreateReportsProjectOutOfScope(t *testing.T) {
+	client := newMockBitwardenClient()
+	accessible := client.addProject(mockOrgId, "accessible")
+	client.secretCreateHook = func(key, value, note string, organizationID string, projectIDs []string) (*sdk.SecretResponse, error) {
+		return nil, errors.New("API error: [404 Not Found] Resource not found")
+	}
+
+	r := &secretResource{bitwardenClient: client, organizationId: mockOrgId}
+	schema := secretResourceTestSchema(t)
+	resp := fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: newTestPlan(t, schema, secretResourceModel{
+		ID:        types.StringUnknown(),
+		Key:       types.StringValue("key"),
+		Value:     types.StringValue("value"),
+		Note:      types.StringValue(""),
+		ProjectID: types.StringValue(validProjectUUID),
+	})}, &resp)
+
+	errors := resp.Diagnostics.Errors()
+	if len(errors) != 1 || errors[0].Summary() != "Unable to Create Secret" {
+		t.Fatalf("expected a single error, got: %v", resp.Diagnostics)
+	}
+	for _, expected := range []string{"not scoped to the project with id: " + validProjectUUID, "- accessible (id: " + accessible.ID + ")"} {
+		if !strings.Contains(errors[0].Detail(), expected) {
+			t.Errorf("expected detail to contain %q, got: %q", expected, errors[0].Detail())
+		}
+	}
+}
+
+func TestDotenvDataSourceReportsProjectOutOfScope(t *testing.T) {
+	client := newMockBitwardenClient()
+	empty := client.addProject(mockOrgId, "empty")
+	d := &dotenvDataSource{bitwardenClient: client, organizationId: mockOrgId}
+	schema := dataSourceTestSchema(t, d)
+
+	tests := map[string]struct {
+		projectId string
+		scoped    bool
+	}{
+		"empty project":        {projectId: empty.ID},
+		"project out of scope": {projectId: validProjectUUID, scoped: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := datasource.ReadResponse{State: tfsdk.State{Schema: schema}}
+			d.Read(context.Background(), datasource.ReadRequest{Config: newTestConfig(t, schema, dotenvDataSourceModel{
+				ProjectID:      types.StringValue(test.projectId),
+				OrganizationID: types.StringNull(),
+			})}, &resp)
+
+			if !test.scoped {
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("unexpected error: %v", resp.Diagnostics)
+				}
+				return
+			}
+			errors := resp.Diagnostics.Errors()
+			if len(errors) != 1 || errors[0].Summary() != "Unable to Read Project Secrets" {
+				t.Fatalf("expected a single error, got: %v", resp.Diagnostics)
+			}
+			for _, expected := range []string{"not scoped to the project with id: " + validProjectUUID, "- empty (id: " + empty.ID + ")"} {
+				if !strings.Contains(errors[0].Detail(), expected) {
+					t.Errorf("expected detail to contain %q, got: %q", expected, errors[0].Detail())
+				}
+			}
+		})
+	}
+}
+
+func TestProjectSecretsResourceReportsProjectOutOfScope(t *testing.T) {
+	client := newMockBitwardenClient()
+	client.addProject(mockOrgId, "accessible")
+	client.secretCreateHook = func(key, value, note string, organizationID string, projectIDs []string) (*sdk.SecretResponse, error) {
+		return nil, errors.New("API error: [404 Not Found] Resource not found")
+	}
+	r := &projectSecretsResource{bitwardenClient: client, organizationId: mockOrgId}
+	schema := resourceTestSchema(t, r)
+
+	resp := fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	newTestPrivateState(&resp.Private)
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: newTestPlan(t, schema, projectSecretsTestModel(validProjectUUID, map[string]projectSecretsResourceSecret{
+		"A": projectSecret("a", ""),
+		"B": projectSecret("b", ""),
+	}))}, &resp)
+
+	errors := resp.Diagnostics.Errors()
+	if len(errors) != 2 {
+		t.Fatalf("expected an error for every secret, got: %v", resp.Diagnostics)
+	}
+	for _, d := range errors {
+		if !strings.Contains(d.Detail(), "not scoped to the project with id: "+validProjectUUID) {
+			t.Errorf("expected detail to explain the scope, got: %q", d.Detail())
+		}
+	}
+	if calls := client.callCount("Projects.List"); calls != 1 {
+		t.Fatalf("expected the projects to be listed once, got: %d", calls)
+	}
+}
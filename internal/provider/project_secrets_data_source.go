@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/bitwarden/sdk-go/v2"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ datasource.DataSource              = &projectSecretsDataSource{}
+	_ datasource.DataSourceWithConfigure = &projectSecretsDataSource{}
+)
+
+func NewProjectSecretsDataSource() datasource.DataSource {
+	return &projectSecretsDataSource{}
+}
+
+// projectSecretsDataSource defines the data source implementation.
+type projectSecretsDataSource struct {
+	bitwardenClient sdk.BitwardenClientInterface
+	projectCache    *projectCache
+	organizationId  string
+	verboseErrors   bool
+	logLevel        string
+	redactKeys      bool
+}
+
+type projectSecretsDataSourceModel struct {
+	ProjectID      types.String                            `tfsdk:"project_id"`
+	OrganizationID types.String                            `tfsdk:"organization_id"`
+	Secrets        map[string]projectSecretDataSourceModel `tfsdk:"secrets"`
+}
+
+type projectSecretDataSourceModel struct {
+	ID    types.String `tfsdk:"id"`
+	Value types.String `tfsdk:"value"`
+	Note  types.String `tfsdk:"note"`
+}
+
+func (d *projectSecretsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_secrets"
+}
+
+func (d *projectSecretsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "The project_secrets data source reads all secrets of a project as a map keyed by the keys of the secrets.",
+		MarkdownDescription: "The `project_secrets` data source reads all secrets of a project as a map keyed by the `key` of the secrets.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description:         "String representation of the ID of the project whose secrets are read.",
+				MarkdownDescription: "String representation of the `ID` of the project whose secrets are read.",
+				Required:            true,
+				Validators: []validator.String{
+					stringUUIDValidate(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description:         "String representation of the ID of the organization to which the project belongs. Overrides the organization configured on the provider.",
+				MarkdownDescription: "String representation of the `ID` of the organization to which the project belongs. Overrides the `organization_id` configured on the provider.",
+				Computed:            true,
+				Optional:            true,
+				Validators: []validator.String{
+					stringUUIDValidate(),
+				},
+			},
+			"secrets": schema.MapNestedAttribute{
+				Description: "Map of the secrets of the project keyed by the key of the secret. " +
+					"Reading fails if the project contains multiple secrets with the same key, because a map cannot hold them.",
+				MarkdownDescription: "Map of the secrets of the project keyed by the `key` of the secret. " +
+					"Reading fails if the project contains multiple secrets with the same `key`, because a map cannot hold them.",
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description:         "String representation of the ID of the secret inside Bitwarden Secrets Manager.",
+							MarkdownDescription: "String representation of the `ID` of the secret inside Bitwarden Secrets Manager.",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							Description:         "String representation of the value of the secret. This attribute is sensitive.",
+							MarkdownDescription: "String representation of the `value` of the secret. This attribute is sensitive.",
+							Computed:            true,
+							Sensitive:           true,
+						},
+						"note": schema.StringAttribute{
+							Description:         "String representation of the note of the secret.",
+							MarkdownDescription: "String representation of the `note` of the secret.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *projectSecretsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	providerDataStruct, ok := configureClient(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	ctx = newLogContext(ctx, providerDataStruct.logLevel)
+	tflog.SubsystemInfo(ctx, logSubsystem, "Configuring Project Secrets Datasource")
+
+	d.bitwardenClient = providerDataStruct.bitwardenClient
+	d.projectCache = providerDataStruct.projectCache
+	d.organizationId = providerDataStruct.organizationId
+	d.verboseErrors = providerDataStruct.verboseErrors
+	d.logLevel = providerDataStruct.logLevel
+	d.redactKeys = providerDataStruct.redactKeys
+
+	tflog.SubsystemInfo(ctx, logSubsystem, "Datasource Configured")
+}
+
+func (d *projectSecretsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, correlationId := newCorrelationContext(ctx, d.logLevel)
+	defer appendCorrelationId(&resp.Diagnostics, correlationId)
+
+	tflog.SubsystemInfo(ctx, logSubsystem, "Reading Project Secrets Datasource")
+
+	var state projectSecretsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer recoverFromPanic(ctx, "Read Project Secrets", state.ProjectID.ValueString(), &resp.Diagnostics)
+
+	if d.bitwardenClient == nil {
+		resp.Diagnostics.AddError(
+			"Client Not Initialized",
+			"The Bitwarden bitwardenClient was not properly initialized.",
+		)
+		return
+	}
+
+	if !checkContext(ctx, "Read Project Secrets", &resp.Diagnostics) {
+		return
+	}
+
+	organizationId := resolveOrganizationId(state.OrganizationID, d.organizationId)
+	secrets, err := listProjectSecrets(d.bitwardenClient, d.projectCache, organizationId, state.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Project Secrets",
+			fmt.Sprintf("Unable to read the secrets of the project with id: %s.\n\n%s%s", state.ProjectID.ValueString(), sdkErrorDetail(err, organizationId, d.verboseErrors),
+				projectScopeDetail(d.bitwardenClient, d.projectCache, organizationId, state.ProjectID.ValueString(), err)),
+		)
+		return
+	}
+
+	state.OrganizationID = types.StringValue(organizationId)
+	state.Secrets = make(map[string]projectSecretDataSourceModel, len(secrets))
+	var duplicates []string
+	for _, secret := range secrets {
+		if _, found := state.Secrets[secret.Key]; found {
+			if displayKey := displaySecretKey(secret.Key, d.redactKeys); !slices.Contains(duplicates, displayKey) {
+				duplicates = append(duplicates, displayKey)
+			}
+			continue
+		}
+		state.Secrets[secret.Key] = projectSecretDataSourceModel{
+			ID:    types.StringValue(secret.ID),
+			Value: types.StringValue(secret.Value),
+			Note:  types.StringValue(secret.Note),
+		}
+	}
+
+	if len(duplicates) > 0 {
+		sort.Strings(duplicates)
+		resp.Diagnostics.AddError(
+			"Duplicated Secret Keys",
+			fmt.Sprintf("The project with id: %s contains multiple secrets with the keys: %s. "+
+				"A map cannot hold multiple secrets with the same key. Rename the secrets or read them with the list_secrets data source.",
+				state.ProjectID.ValueString(), strings.Join(duplicates, ", ")),
+		)
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
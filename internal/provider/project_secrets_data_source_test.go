@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"testing"
+)
+
+func TestProjectSecretsDataSourceRead(t *testing.T) {
+	client := newMockBitwardenClient()
+	project := client.addProject(mockOrgId, "app")
+	otherProject := client.addProject(mockOrgId, "other")
+	apiKey := client.addSecret("API_KEY", "abc123", "rotated yearly", mockOrgId, project.ID)
+	client.addSecret("DB_PASSWORD", "p@ss word", "", mockOrgId, project.ID)
+	client.addSecret("OTHER", "ignored", "", mockOrgId, otherProject.ID)
+
+	d := &projectSecretsDataSource{bitwardenClient: client, organizationId: mockOrgId}
+	schema := dataSourceTestSchema(t, d)
+	read := func(projectId string) datasource.ReadResponse {
+		req := datasource.ReadRequest{Config: newTestConfig(t, schema, projectSecretsDataSourceModel{
+			ProjectID:      types.StringValue(projectId),
+			OrganizationID: types.StringNull(),
+		})}
+		resp := datasource.ReadResponse{State: tfsdk.State{Schema: schema}}
+		d.Read(context.Background(), req, &resp)
+		return resp
+	}
+
+	resp := read(project.ID)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var state projectSecretsDataSourceModel
+	resp.State.Get(context.Background(), &state)
+	if len(state.Secrets) != 2 {
+		t.Fatalf("expected 2 secrets, got: %v", state.Secrets)
+	}
+	secret := state.Secrets["API_KEY"]
+	if secret.ID.ValueString() != apiKey.ID || secret.Value.ValueString() != "abc123" || secret.Note.ValueString() != "rotated yearly" {
+		t.Errorf("unexpected secret API_KEY: %v", secret)
+	}
+
+	client.addSecret("API_KEY", "def456", "", mockOrgId, project.ID)
+	resp = read(project.ID)
+	if !diagnosticsContain(resp.Diagnostics, "Duplicated Secret Keys") {
+		t.Fatalf("expected duplicated keys error, got: %v", resp.Diagnostics)
+	}
+}
@@ -0,0 +1,982 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/bitwarden/sdk-go/v2"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource                   = &projectSecretsResource{}
+	_ resource.ResourceWithConfigure      = &projectSecretsResource{}
+	_ resource.ResourceWithImportState    = &projectSecretsResource{}
+	_ resource.ResourceWithValidateConfig = &projectSecretsResource{}
+	_ resource.ResourceWithModifyPlan     = &projectSecretsResource{}
+)
+
+func NewProjectSecretsResource() resource.Resource {
+	return &projectSecretsResource{}
+}
+
+// projectSecretsResource defines the resource implementation. It manages a set of secrets of a project as one unit and
+// tracks the ID of every managed secret by its key. Secrets of the project which it did not create or import are never
+// touched.
+type projectSecretsResource struct {
+	bitwardenClient       sdk.BitwardenClientInterface
+	projectCache          *projectCache
+	summary               *operationSummary
+	organizationId        string
+	ignoreMissingOnDelete bool
+	verboseErrors         bool
+	logLevel              string
+	redactKeys            bool
+}
+
+type projectSecretsResourceModel struct {
+	ID             types.String                            `tfsdk:"id"`
+	ProjectID      types.String                            `tfsdk:"project_id"`
+	OrganizationID types.String                            `tfsdk:"organization_id"`
+	Secrets        map[string]projectSecretsResourceSecret `tfsdk:"secrets"`
+	SecretIDs      types.Map                               `tfsdk:"secret_ids"`
+	ChangeSummary  types.String                            `tfsdk:"change_summary"`
+}
+
+type projectSecretsResourceSecret struct {
+	Value types.String `tfsdk:"value"`
+	Note  types.String `tfsdk:"note"`
+}
+
+func (r *projectSecretsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_secrets"
+}
+
+func (r *projectSecretsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The project_secrets resource manages a set of secrets of a project as one unit. " +
+			"Secrets are created, updated and deleted so that the managed secrets match the configured map exactly. " +
+			"Other secrets of the project are not affected.",
+		MarkdownDescription: "The `project_secrets` resource manages a set of secrets of a project as one unit. " +
+			"Secrets are created, updated and deleted so that the managed secrets match the configured map exactly. " +
+			"Other secrets of the project are not affected.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "String representation of the ID of the project whose secrets are managed.",
+				MarkdownDescription: "String representation of the `ID` of the project whose secrets are managed.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description:         "String representation of the ID of the project whose secrets are managed. Changing the project replaces all managed secrets.",
+				MarkdownDescription: "String representation of the `ID` of the project whose secrets are managed. Changing the project replaces all managed secrets.",
+				Required:            true,
+				Validators: []validator.String{
+					stringUUIDValidate(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description:         "String representation of the ID of the organization to which the project belongs.",
+				MarkdownDescription: "String representation of the `ID` of the organization to which the project belongs.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"secrets": schema.MapNestedAttribute{
+				Description: "Map of the managed secrets keyed by the key of the secret. Adding a key creates a secret, removing a key deletes its secret. " +
+					"Keys must be unique, so secrets whose key is changed in Bitwarden Secrets Manager are recreated with the configured key.",
+				MarkdownDescription: "Map of the managed secrets keyed by the `key` of the secret. Adding a key creates a secret, removing a key deletes its secret. " +
+					"Keys must be unique, so secrets whose `key` is changed in Bitwarden Secrets Manager are recreated with the configured `key`.",
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"value": schema.StringAttribute{
+							Description:         "String representation of the value of the secret. This attribute is sensitive.",
+							MarkdownDescription: "String representation of the `value` of the secret. This attribute is sensitive.",
+							Required:            true,
+							Sensitive:           true,
+						},
+						"note": schema.StringAttribute{
+							Description:         "String representation of the note of the secret. The provided default is an empty note.",
+							MarkdownDescription: "String representation of the `note` of the secret. The provided default is an empty `note`.",
+							Computed:            true,
+							Optional:            true,
+							Default:             stringdefault.StaticString(""),
+						},
+					},
+				},
+			},
+			"secret_ids": schema.MapAttribute{
+				Description:         "Map of the IDs of the managed secrets inside Bitwarden Secrets Manager keyed by the key of the secret.",
+				MarkdownDescription: "Map of the `IDs` of the managed secrets inside Bitwarden Secrets Manager keyed by the `key` of the secret.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"change_summary": schema.StringAttribute{
+				Description: "A compact summary of the changes of the secrets map, i.e. the number of secrets to create, update and delete, the number of unchanged secrets and the keys of the changed secrets. " +
+					"It never contains values and is planned for every apply which changes secrets, so that reviews of large maps can start from the summary. It is kept as it is while no secrets change.",
+				MarkdownDescription: "A compact summary of the changes of the `secrets` map, i.e. the number of secrets to create, update and delete, the number of unchanged secrets and the keys of the changed secrets. " +
+					"It never contains values and is planned for every apply which changes secrets, so that reviews of large maps can start from the summary. It is kept as it is while no secrets change.",
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *projectSecretsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	providerDataStruct, ok := configureClient(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	ctx = newLogContext(ctx, providerDataStruct.logLevel)
+	tflog.SubsystemInfo(ctx, logSubsystem, "Configuring Project Secrets Resource")
+
+	r.bitwardenClient = providerDataStruct.bitwardenClient
+	r.projectCache = providerDataStruct.projectCache
+	r.summary = providerDataStruct.summary
+	r.organizationId = providerDataStruct.organizationId
+	r.ignoreMissingOnDelete = providerDataStruct.ignoreMissingOnDelete
+	r.verboseErrors = providerDataStruct.verboseErrors
+	r.logLevel = providerDataStruct.logLevel
+	r.redactKeys = providerDataStruct.redactKeys
+
+	tflog.SubsystemInfo(ctx, logSubsystem, "Resource Configured")
+}
+
+func (r *projectSecretsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, correlationId := newCorrelationContext(ctx, r.logLevel)
+	defer appendCorrelationId(&resp.Diagnostics, correlationId)
+	defer r.summary.recordIfSucceeded(ctx, "create", &resp.Diagnostics)
+
+	defer recoverFromPanic(ctx, "Create Project Secrets", "", &resp.Diagnostics)
+
+	var plan projectSecretsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.bitwardenClient == nil {
+		resp.Diagnostics.AddError(
+			"Client Not Initialized",
+			"The Bitwarden client was not properly initialized.",
+		)
+		return
+	}
+
+	if !checkContext(ctx, "Create Project Secrets", &resp.Diagnostics) {
+		return
+	}
+
+	state := projectSecretsResourceModel{
+		ID:             plan.ProjectID,
+		ProjectID:      plan.ProjectID,
+		OrganizationID: types.StringValue(r.organizationId),
+		Secrets:        map[string]projectSecretsResourceSecret{},
+	}
+	secretIds := map[string]string{}
+
+	// Secrets created before a failure are stored in the state, so that they are not orphaned. Terraform marks the
+	// resource as tainted and replaces it with the next apply, unless it is untainted to continue the creation.
+	state.ChangeSummary = plan.ChangeSummary
+	if plan.ChangeSummary.IsUnknown() {
+		state.ChangeSummary = types.StringValue(summarizeSecretChanges(plan.Secrets, nil, r.redactKeys))
+	}
+	progress := r.applySecrets(ctx, plan, &state, secretIds, &resp.Diagnostics)
+	resp.Diagnostics.Append(recordApplyProgress(ctx, progress, resp.Private)...)
+
+	state.SecretIDs = secretIdsValue(secretIds)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectSecretsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, correlationId := newCorrelationContext(ctx, r.logLevel)
+	defer appendCorrelationId(&resp.Diagnostics, correlationId)
+
+	tflog.SubsystemInfo(ctx, logSubsystem, "Reading Project Secrets Resource")
+
+	var state projectSecretsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer recoverFromPanic(ctx, "Read Project Secrets", state.ProjectID.ValueString(), &resp.Diagnostics)
+
+	if r.bitwardenClient == nil {
+		resp.Diagnostics.AddError(
+			"Client Not Initialized",
+			"The Bitwarden client was not properly initialized.",
+		)
+		return
+	}
+
+	if !checkContext(ctx, "Read Project Secrets", &resp.Diagnostics) {
+		return
+	}
+
+	// Imported resources do not track any secrets yet and adopt all secrets of the project.
+	if state.SecretIDs.IsNull() {
+		storedOption, diags := req.Private.GetKey(ctx, onDuplicateKeyPrivateKey)
+		resp.Diagnostics.Append(diags...)
+		var onDuplicateKey string
+		if storedOption != nil {
+			// The option was validated by the import, so a malformed value falls back to fail.
+			_ = json.Unmarshal(storedOption, &onDuplicateKey)
+		}
+		r.adoptProjectSecrets(ctx, &state, onDuplicateKey, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if storedOption != nil {
+			resp.Diagnostics.Append(resp.Private.SetKey(ctx, onDuplicateKeyPrivateKey, nil)...)
+		}
+		diags = resp.State.Set(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	trackedIds, diags := secretIdsFromValue(ctx, state.SecretIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteSecrets, err := r.readTrackedSecrets(trackedIds)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Project Secrets",
+			fmt.Sprintf("Unable to read the secrets managed in the project with id: %s.\n\n%s", state.ProjectID.ValueString(), sdkErrorDetail(err, r.organizationId, r.verboseErrors)),
+		)
+		return
+	}
+
+	secrets := map[string]projectSecretsResourceSecret{}
+	secretIds := map[string]string{}
+	for _, key := range sortedKeys(trackedIds) {
+		secret, found := remoteSecrets[trackedIds[key]]
+		if !found {
+			// The secret was deleted outside of Terraform and is recreated by the next apply.
+			tflog.SubsystemWarn(ctx, logSubsystem, "Managed secret not found, removing it from state", map[string]any{
+				"id":  trackedIds[key],
+				"key": displaySecretKey(key, r.redactKeys),
+			})
+			continue
+		}
+
+		// A secret whose key was changed outside of Terraform is tracked by its new key, so that the next apply
+		// deletes it and recreates the configured key.
+		remoteKey := key
+		_, tracked := trackedIds[secret.Key]
+		_, taken := secrets[secret.Key]
+		if !tracked && !taken {
+			remoteKey = secret.Key
+		}
+		secrets[remoteKey] = projectSecretsResourceSecret{
+			Value: types.StringValue(secret.Value),
+			Note:  types.StringValue(secret.Note),
+		}
+		secretIds[remoteKey] = secret.ID
+	}
+
+	state.Secrets = secrets
+	state.SecretIDs = secretIdsValue(secretIds)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectSecretsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, correlationId := newCorrelationContext(ctx, r.logLevel)
+	defer appendCorrelationId(&resp.Diagnostics, correlationId)
+	defer r.summary.recordIfSucceeded(ctx, "update", &resp.Diagnostics)
+
+	var plan, state projectSecretsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer recoverFromPanic(ctx, "Update Project Secrets", state.ProjectID.ValueString(), &resp.Diagnostics)
+
+	if r.bitwardenClient == nil {
+		resp.Diagnostics.AddError(
+			"Client Not Initialized",
+			"The Bitwarden client was not properly initialized.",
+		)
+		return
+	}
+
+	if !checkContext(ctx, "Update Project Secrets", &resp.Diagnostics) {
+		return
+	}
+
+	secretIds, diags := secretIdsFromValue(ctx, state.SecretIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var removedKeys []string
+	for key := range secretIds {
+		if _, configured := plan.Secrets[key]; !configured {
+			removedKeys = append(removedKeys, key)
+		}
+	}
+	sort.Strings(removedKeys)
+
+	changeSummary := plan.ChangeSummary
+	if changeSummary.IsUnknown() {
+		changeSummary = types.StringValue(summarizeSecretChanges(plan.Secrets, state.Secrets, r.redactKeys))
+	}
+	state.ChangeSummary = changeSummary
+
+	// The state always reflects the completed operations, so that a failed update is continued by the next apply. A
+	// failed deletion does not prevent the configured secrets from being applied.
+	if len(removedKeys) > 0 {
+		r.deleteSecrets(ctx, removedKeys, &state, secretIds, &resp.Diagnostics)
+	}
+	progress := r.applySecrets(ctx, plan, &state, secretIds, &resp.Diagnostics)
+	resp.Diagnostics.Append(recordApplyProgress(ctx, progress, resp.Private)...)
+
+	state.SecretIDs = secretIdsValue(secretIds)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectSecretsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, correlationId := newCorrelationContext(ctx, r.logLevel)
+	defer appendCorrelationId(&resp.Diagnostics, correlationId)
+	defer r.summary.recordIfSucceeded(ctx, "delete", &resp.Diagnostics)
+
+	var state projectSecretsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer recoverFromPanic(ctx, "Delete Project Secrets", state.ProjectID.ValueString(), &resp.Diagnostics)
+
+	if r.bitwardenClient == nil {
+		resp.Diagnostics.AddError(
+			"Client Not Initialized",
+			"The Bitwarden client was not properly initialized.",
+		)
+		return
+	}
+
+	if !checkContext(ctx, "Delete Project Secrets", &resp.Diagnostics) {
+		return
+	}
+
+	secretIds, diags := secretIdsFromValue(ctx, state.SecretIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || len(secretIds) == 0 {
+		return
+	}
+
+	r.deleteSecrets(ctx, sortedKeys(secretIds), &state, secretIds, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		// Keep the secrets which could not be deleted in the state.
+		state.SecretIDs = secretIdsValue(secretIds)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	}
+}
+
+func (r *projectSecretsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// The keys can only be validated once the map is known.
+	var secrets types.Map
+	diags := req.Config.GetAttribute(ctx, path.Root("secrets"), &secrets)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || secrets.IsNull() || secrets.IsUnknown() {
+		return
+	}
+
+	for key := range secrets.Elements() {
+		// The error is attached to the map instead of the key, so that the path does not reveal the key.
+		if err := validateSecretKey(key, false); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("secrets"),
+				"Invalid Secret Key",
+				err.Error(),
+			)
+		}
+	}
+}
+
+// ModifyPlan keeps the IDs of the secrets whose keys remain configured, so that only added keys show an unknown ID, and
+// plans the change_summary.
+func (r *projectSecretsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ctx, correlationId := newCorrelationContext(ctx, r.logLevel)
+	defer appendCorrelationId(&resp.Diagnostics, correlationId)
+
+	// Nothing to do on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.planChangeSummary(ctx, req, resp)
+	if resp.Diagnostics.HasError() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plannedSecrets types.Map
+	var plannedProjectId types.String
+	var state projectSecretsResourceModel
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("secrets"), &plannedSecrets)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("project_id"), &plannedProjectId)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plannedSecrets.IsUnknown() || !plannedProjectId.Equal(state.ProjectID) {
+		return
+	}
+
+	progress, diags := storedApplyProgress(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if progress != nil {
+		resp.Diagnostics.AddWarning(
+			"Resuming Interrupted Apply",
+			fmt.Sprintf("The previous apply of the secrets of the project with id: %s stopped after %d secrets were applied, %d secrets were still pending. "+
+				"This apply only continues with the secrets which still differ from the configuration.", state.ProjectID.ValueString(), len(progress.Done), len(progress.Pending)),
+		)
+	}
+
+	stateIds, diags := secretIdsFromValue(ctx, state.SecretIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plannedIds := make(map[string]attr.Value, len(plannedSecrets.Elements()))
+	for key := range plannedSecrets.Elements() {
+		if id, found := stateIds[key]; found {
+			plannedIds[key] = types.StringValue(id)
+		} else {
+			plannedIds[key] = types.StringUnknown()
+		}
+	}
+	plannedIdsValue, diags := types.MapValue(types.StringType, plannedIds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("secret_ids"), plannedIdsValue)...)
+}
+
+// planChangeSummary plans the change_summary from the differences between the planned and the current secrets. The
+// summary of the state is kept if no secrets change, so that unchanged resources show no difference.
+func (r *projectSecretsResource) planChangeSummary(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// The summary is determined by the apply if the planned secrets are not known yet, e.g. if whole entries of the
+	// map are computed from other resources.
+	var planned map[string]projectSecretsResourceSecret
+	var plannedProjectId types.String
+	if diags := req.Plan.GetAttribute(ctx, path.Root("secrets"), &planned); diags.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("project_id"), &plannedProjectId)...)
+	var state projectSecretsResourceModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Secrets of another project are replaced, so all secrets of the plan are created.
+	prior := state.Secrets
+	if !plannedProjectId.Equal(state.ProjectID) {
+		prior = nil
+	}
+
+	changeSummary := state.ChangeSummary
+	if req.State.Raw.IsNull() || secretsChanged(planned, prior) {
+		changeSummary = types.StringValue(summarizeSecretChanges(planned, prior, r.redactKeys))
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("change_summary"), changeSummary)...)
+}
+
+// ImportState imports all secrets of a project by the ID of the project. The ID may be followed by the on_duplicate_key
+// import option, e.g. <project id>:newest, which selects the secret which is adopted for a duplicated key.
+func (r *projectSecretsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, correlationId := newCorrelationContext(ctx, r.logLevel)
+	defer appendCorrelationId(&resp.Diagnostics, correlationId)
+
+	projectId, onDuplicateKey, _ := strings.Cut(req.ID, ":")
+	if err := uuid.Validate(projectId); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("The import ID: %s is not a valid project ID. The secrets of a project can only be imported by the UUID of the project.", req.ID),
+		)
+		return
+	}
+	if onDuplicateKey != "" && !slices.Contains([]string{"fail", "newest", "oldest"}, onDuplicateKey) {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("The import ID: %s contains the invalid on_duplicate_key option: %s. Valid options are fail, newest and oldest, e.g. %s:newest.", req.ID, onDuplicateKey, projectId),
+		)
+		return
+	}
+
+	if r.bitwardenClient != nil && !r.validateImportOrganization(ctx, projectId, &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), projectId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectId)...)
+	if onDuplicateKey != "" {
+		// Values in private state must be valid JSON.
+		value, _ := json.Marshal(onDuplicateKey)
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, onDuplicateKeyPrivateKey, value)...)
+	}
+}
+
+// validateImportOrganization verifies that the imported project belongs to the organization of the provider. The
+// secrets of a project are listed within the organization of the provider, so importing a project of another
+// organization would silently adopt no secrets. Other errors are reported by the read following the import.
+func (r *projectSecretsResource) validateImportOrganization(ctx context.Context, projectId string, diags *diag.Diagnostics) bool {
+	defer recoverFromPanic(ctx, "Import Project Secrets", projectId, diags)
+
+	project, err := r.bitwardenClient.Projects().Get(projectId)
+	if err != nil || project == nil || project.OrganizationID == r.organizationId {
+		return true
+	}
+	diags.AddError(
+		"Imported Project Belongs to Another Organization",
+		fmt.Sprintf("The project with id: %s belongs to the organization with id: %s, but the provider manages secrets in the organization with id: %s. "+
+			"Import the secrets of the project with a provider configured for its organization.", projectId, project.OrganizationID, r.organizationId),
+	)
+	return false
+}
+
+// applySecrets creates the configured secrets which are not tracked yet and updates the tracked secrets whose value or
+// note changed. A failed secret is reported with its key and operation and the remaining secrets are still applied. It
+// stops once the apply is interrupted, records every completed operation in state and secretIds and returns which keys
+// were applied and, if the apply was interrupted, which are still pending, including the failed keys.
+func (r *projectSecretsResource) applySecrets(ctx context.Context, plan projectSecretsResourceModel, state *projectSecretsResourceModel, secretIds map[string]string, diags *diag.Diagnostics) applyProgress {
+	var progress applyProgress
+	for _, key := range sortedKeys(plan.Secrets) {
+		configured := plan.Secrets[key]
+		if current, tracked := state.Secrets[key]; tracked && current.Value.Equal(configured.Value) && current.Note.Equal(configured.Note) {
+			continue
+		}
+		progress.Pending = append(progress.Pending, key)
+	}
+
+	projectIds := []string{plan.ProjectID.ValueString()}
+	var failed []string
+	// All secrets share the project, so the projects of the machine account are listed at most once.
+	var scopeDetail *string
+	for len(progress.Pending) > len(failed) {
+		// Terraform cancels the context when the apply is interrupted, the completed operations are kept in the state.
+		if ctx.Err() != nil {
+			diags.AddError(
+				"Apply Interrupted",
+				fmt.Sprintf("The apply was interrupted after %d of %d secrets of the project with id: %s were applied. "+
+					"The applied secrets are kept in the Terraform state and the next apply continues with the remaining secrets.",
+					len(progress.Done), len(progress.Done)+len(progress.Pending), plan.ProjectID.ValueString()),
+			)
+			return progress
+		}
+
+		// Failed keys are moved to the front of the pending keys, so that the next key to apply follows them.
+		key := progress.Pending[len(failed)]
+		configured := plan.Secrets[key]
+
+		var secret *sdk.SecretResponse
+		var err error
+		operation := "Create"
+		if id, tracked := secretIds[key]; tracked {
+			operation = "Update"
+			secret, err = r.bitwardenClient.Secrets().Update(id, key, configured.Value.ValueString(), configured.Note.ValueString(), state.OrganizationID.ValueString(), projectIds)
+		} else {
+			secret, err = r.bitwardenClient.Secrets().Create(key, configured.Value.ValueString(), configured.Note.ValueString(), state.OrganizationID.ValueString(), projectIds)
+		}
+		if err == nil {
+			err = validateSecretResponse(secret)
+		}
+		if err != nil {
+			detail := ""
+			if isNotFoundError(err.Error()) || isAccessDeniedError(err.Error()) {
+				if scopeDetail == nil {
+					detail = projectScopeDetail(r.bitwardenClient, r.projectCache, r.organizationId, plan.ProjectID.ValueString(), err)
+					scopeDetail = &detail
+				}
+				detail = *scopeDetail
+			}
+			diags.AddAttributeError(
+				path.Root("secrets").AtMapKey(key),
+				fmt.Sprintf("Unable to %s Secret", operation),
+				fmt.Sprintf("Unable to %s the secret with the key \"%s\" in the project with id: %s.\n\n%s%s", operation, displaySecretKey(key, r.redactKeys), plan.ProjectID.ValueString(),
+					sdkErrorDetail(err, r.organizationId, r.verboseErrors, configured.Value.ValueString(), r.sensitiveKey(key)), detail),
+			)
+			tflog.SubsystemWarn(ctx, logSubsystem, "Unable to apply managed secret", map[string]any{
+				"operation": operation,
+				"key":       displaySecretKey(key, r.redactKeys),
+			})
+			failed = append(failed, key)
+			continue
+		}
+
+		tflog.SubsystemDebug(ctx, logSubsystem, "Applied managed secret", map[string]any{
+			"operation": operation,
+			"id":        secret.ID,
+			"key":       displaySecretKey(key, r.redactKeys),
+		})
+		state.Secrets[key] = configured
+		secretIds[key] = secret.ID
+		progress.Done = append(progress.Done, key)
+		progress.Pending = slices.Delete(progress.Pending, len(failed), len(failed)+1)
+	}
+	// Failed keys are reported by their errors and retried by the next apply like any other change, so only an
+	// interrupted apply leaves keys pending.
+	progress.Pending = nil
+	return progress
+}
+
+// deleteSecrets deletes the tracked secrets with the given keys and removes every deleted secret from state and
+// secretIds. Secrets which no longer exist are removed as well if ignore_missing_on_delete is enabled.
+func (r *projectSecretsResource) deleteSecrets(ctx context.Context, keys []string, state *projectSecretsResourceModel, secretIds map[string]string, diags *diag.Diagnostics) {
+	keysById := make(map[string]string, len(keys))
+	ids := make([]string, 0, len(keys))
+	for _, key := range keys {
+		keysById[secretIds[key]] = key
+		ids = append(ids, secretIds[key])
+	}
+
+	response, err := r.bitwardenClient.Secrets().Delete(ids)
+	if err != nil {
+		diags.AddError(
+			"Unable to Delete Secrets",
+			fmt.Sprintf("Unable to delete the secrets managed in the project with id: %s.\n\n%s", state.ProjectID.ValueString(), sdkErrorDetail(err, r.organizationId, r.verboseErrors)),
+		)
+		return
+	}
+	if response == nil {
+		diags.AddError(
+			"Unexpected Bitwarden Secrets Manager Response",
+			"The Bitwarden Secrets Manager API returned an empty response when deleting secrets of the project with id: "+state.ProjectID.ValueString(),
+		)
+		return
+	}
+
+	for _, result := range response.Data {
+		key, found := keysById[result.ID]
+		if !found {
+			continue
+		}
+		if result.Error != nil && !(r.ignoreMissingOnDelete && isNotFoundError(*result.Error)) {
+			diags.AddAttributeError(
+				path.Root("secrets").AtMapKey(key),
+				"Unable to Delete Secret",
+				fmt.Sprintf("Unable to delete the secret with the key \"%s\" and the id: %s.\n\n%s", displaySecretKey(key, r.redactKeys), result.ID,
+					sdkErrorDetail(errors.New(*result.Error), r.organizationId, r.verboseErrors)),
+			)
+			continue
+		}
+
+		tflog.SubsystemDebug(ctx, logSubsystem, "Deleted managed secret", map[string]any{"id": result.ID, "key": displaySecretKey(key, r.redactKeys)})
+		delete(state.Secrets, key)
+		delete(secretIds, key)
+	}
+}
+
+// adoptProjectSecrets tracks all secrets of the project after an import. Keys are not unique inside Bitwarden Secrets
+// Manager, so projects with duplicated keys cannot be imported unless onDuplicateKey is newest or oldest, which adopts
+// the secret with the latest or earliest revision date of every duplicated key and leaves the others unmanaged.
+func (r *projectSecretsResource) adoptProjectSecrets(ctx context.Context, state *projectSecretsResourceModel, onDuplicateKey string, diags *diag.Diagnostics) {
+	secrets, err := listProjectSecrets(r.bitwardenClient, r.projectCache, r.organizationId, state.ProjectID.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Unable to Read Project Secrets",
+			fmt.Sprintf("Unable to read the secrets of the project with id: %s.\n\n%s%s", state.ProjectID.ValueString(), sdkErrorDetail(err, r.organizationId, r.verboseErrors),
+				projectScopeDetail(r.bitwardenClient, r.projectCache, r.organizationId, state.ProjectID.ValueString(), err)),
+		)
+		return
+	}
+
+	secretsByKey := map[string][]sdk.SecretResponse{}
+	for _, secret := range secrets {
+		secretsByKey[secret.Key] = append(secretsByKey[secret.Key], secret)
+	}
+
+	state.OrganizationID = types.StringValue(r.organizationId)
+	state.Secrets = map[string]projectSecretsResourceSecret{}
+	secretIds := map[string]string{}
+	var resolvedKeys []string
+	for _, key := range sortedKeys(secretsByKey) {
+		candidates := secretsByKey[key]
+		if len(candidates) > 1 && onDuplicateKey != "newest" && onDuplicateKey != "oldest" {
+			diags.AddError(
+				"Duplicated Secret Key",
+				fmt.Sprintf("The project with id: %s contains multiple secrets with the key \"%s\", so its secrets cannot be imported. "+
+					"Rename or delete the duplicated secrets first, or import the newest or oldest of them with the on_duplicate_key import option, e.g. %s:newest.",
+					state.ProjectID.ValueString(), displaySecretKey(key, r.redactKeys), state.ProjectID.ValueString()),
+			)
+			return
+		}
+
+		secret := selectDuplicatedSecret(candidates, onDuplicateKey)
+		if len(candidates) > 1 {
+			var skippedIds []string
+			for _, candidate := range candidates {
+				if candidate.ID != secret.ID {
+					skippedIds = append(skippedIds, candidate.ID)
+				}
+			}
+			tflog.SubsystemInfo(ctx, logSubsystem, "Selected secret of duplicated key", map[string]any{
+				"key":              displaySecretKey(key, r.redactKeys),
+				"on_duplicate_key": onDuplicateKey,
+				"id":               secret.ID,
+				"revision_date":    secret.RevisionDate.String(),
+				"skipped_ids":      skippedIds,
+			})
+			resolvedKeys = append(resolvedKeys, fmt.Sprintf("%s (%s)", displaySecretKey(key, r.redactKeys), secret.ID))
+		}
+
+		state.Secrets[key] = projectSecretsResourceSecret{
+			Value: types.StringValue(secret.Value),
+			Note:  types.StringValue(secret.Note),
+		}
+		secretIds[key] = secret.ID
+	}
+	state.SecretIDs = secretIdsValue(secretIds)
+
+	if len(resolvedKeys) > 0 {
+		diags.AddWarning(
+			"Duplicated Secret Keys Imported",
+			fmt.Sprintf("The project with id: %s contains multiple secrets with the same key. The %s secret by revision date was imported for the keys: %s. "+
+				"The other secrets with these keys are not managed by Terraform.", state.ProjectID.ValueString(), onDuplicateKey, strings.Join(resolvedKeys, ", ")),
+		)
+	}
+
+	tflog.SubsystemInfo(ctx, logSubsystem, "Imported project secrets", map[string]any{"project_id": state.ProjectID.ValueString(), "count": len(secretIds)})
+}
+
+// selectDuplicatedSecret returns the secret with the latest revision date if onDuplicateKey is newest, otherwise the
+// secret with the earliest revision date. Equal revision dates are ordered by ID, so that the selection is stable.
+func selectDuplicatedSecret(secrets []sdk.SecretResponse, onDuplicateKey string) sdk.SecretResponse {
+	selected := secrets[0]
+	for _, secret := range secrets[1:] {
+		later := secret.RevisionDate.After(selected.RevisionDate) || (secret.RevisionDate.Equal(selected.RevisionDate) && secret.ID > selected.ID)
+		if later == (onDuplicateKey == "newest") {
+			selected = secret
+		}
+	}
+	return selected
+}
+
+// onDuplicateKeyPrivateKey is the private state key which passes the on_duplicate_key import option to the first read.
+const onDuplicateKeyPrivateKey = "on_duplicate_key"
+
+// readTrackedSecrets reads the tracked secrets by their IDs. Secrets which no longer exist are missing in the result.
+func (r *projectSecretsResource) readTrackedSecrets(secretIds map[string]string) (map[string]sdk.SecretResponse, error) {
+	ids := make([]string, 0, len(secretIds))
+	for _, id := range secretIds {
+		ids = append(ids, id)
+	}
+
+	secrets := make(map[string]sdk.SecretResponse, len(ids))
+	if len(ids) == 0 {
+		return secrets, nil
+	}
+
+	response, err := r.bitwardenClient.Secrets().GetByIDS(ids)
+	if err == nil && response != nil {
+		for _, secret := range response.Data {
+			secrets[secret.ID] = secret
+		}
+		return secrets, nil
+	}
+	if err != nil && !isNotFoundError(err.Error()) {
+		return nil, err
+	}
+
+	// The bulk read fails as a whole if any secret is missing, so the secrets are read one by one.
+	for _, id := range ids {
+		secret, err := readSecret(r.bitwardenClient, id)
+		if err != nil && isNotFoundError(err.Error()) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		secrets[id] = *secret
+	}
+	return secrets, nil
+}
+
+// applyProgressPrivateKey is the private state key which records the progress of an apply which stopped before all
+// secrets were applied, until an apply completes.
+const applyProgressPrivateKey = "apply_progress"
+
+// applyProgress records which keys were applied by an apply and which were still pending when it stopped. The state
+// already contains every applied secret, so the progress is only used to report the resumption of an apply.
+type applyProgress struct {
+	Done    []string `json:"done"`
+	Pending []string `json:"pending"`
+}
+
+// storedApplyProgress returns the progress of the previous apply if it stopped before all secrets were applied.
+func storedApplyProgress(ctx context.Context, private privateState) (*applyProgress, diag.Diagnostics) {
+	stored, diags := private.GetKey(ctx, applyProgressPrivateKey)
+	if diags.HasError() || stored == nil {
+		return nil, diags
+	}
+	var progress applyProgress
+	if err := json.Unmarshal(stored, &progress); err != nil {
+		// Malformed progress only affects the reporting, as the state contains every applied secret.
+		return nil, diags
+	}
+	return &progress, diags
+}
+
+// recordApplyProgress stores the progress of an apply which stopped before all secrets were applied, including the keys
+// applied by previous attempts, and clears the stored progress once an apply completes.
+func recordApplyProgress(ctx context.Context, progress applyProgress, private privateState) diag.Diagnostics {
+	previous, diags := storedApplyProgress(ctx, private)
+	if diags.HasError() {
+		return diags
+	}
+	if len(progress.Pending) == 0 {
+		if previous != nil {
+			diags.Append(private.SetKey(ctx, applyProgressPrivateKey, nil)...)
+		}
+		return diags
+	}
+
+	if previous != nil {
+		for _, key := range previous.Done {
+			if !slices.Contains(progress.Done, key) && !slices.Contains(progress.Pending, key) {
+				progress.Done = append(progress.Done, key)
+			}
+		}
+		sort.Strings(progress.Done)
+	}
+	value, err := json.Marshal(progress)
+	if err != nil {
+		diags.AddError("Unable to Store Apply Progress", err.Error())
+		return diags
+	}
+	diags.Append(private.SetKey(ctx, applyProgressPrivateKey, value)...)
+	return diags
+}
+
+// sensitiveKey returns the given secret key if it must be redacted from raw errors of the Bitwarden SDK because
+// redact_keys is enabled, otherwise an empty string, which is never redacted.
+func (r *projectSecretsResource) sensitiveKey(key string) string {
+	if !r.redactKeys {
+		return ""
+	}
+	return key
+}
+
+// secretIdsValue converts the IDs of the managed secrets by key into the value of the secret_ids attribute.
+func secretIdsValue(secretIds map[string]string) types.Map {
+	elements := make(map[string]attr.Value, len(secretIds))
+	for key, id := range secretIds {
+		elements[key] = types.StringValue(id)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+// secretIdsFromValue converts the value of the secret_ids attribute into the IDs of the managed secrets by key.
+func secretIdsFromValue(ctx context.Context, value types.Map) (map[string]string, diag.Diagnostics) {
+	secretIds := map[string]string{}
+	if value.IsNull() || value.IsUnknown() {
+		return secretIds, nil
+	}
+	diags := value.ElementsAs(ctx, &secretIds, false)
+	return secretIds, diags
+}
+
+// sortedKeys returns the keys of the given map in ascending order.
+func sortedKeys[V any](values map[string]V) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// secretsChanged reports whether any secret is created, updated or deleted to reach the planned secrets from the prior
+// secrets.
+func secretsChanged(planned map[string]projectSecretsResourceSecret, prior map[string]projectSecretsResourceSecret) bool {
+	if len(planned) != len(prior) {
+		return true
+	}
+	for key, configured := range planned {
+		current, found := prior[key]
+		if !found || !current.Value.Equal(configured.Value) || !current.Note.Equal(configured.Note) {
+			return true
+		}
+	}
+	return false
+}
+
+// summarizeSecretChanges renders the change_summary of the changes from the prior to the planned secrets, e.g.
+//
+//	1 to create, 2 to update, 0 to delete, 120 unchanged
+//	create: NEW_KEY
+//	update: API_KEY, DATABASE_URL
+//
+// Values are never part of the summary, keys are redacted if redactKeys is true.
+func summarizeSecretChanges(planned map[string]projectSecretsResourceSecret, prior map[string]projectSecretsResourceSecret, redactKeys bool) string {
+	var created, updated, deleted []string
+	unchanged := 0
+	for _, key := range sortedKeys(planned) {
+		configured := planned[key]
+		current, found := prior[key]
+		switch {
+		case !found:
+			created = append(created, displaySecretKey(key, redactKeys))
+		case !current.Value.Equal(configured.Value) || !current.Note.Equal(configured.Note):
+			updated = append(updated, displaySecretKey(key, redactKeys))
+		default:
+			unchanged++
+		}
+	}
+	for _, key := range sortedKeys(prior) {
+		if _, found := planned[key]; !found {
+			deleted = append(deleted, displaySecretKey(key, redactKeys))
+		}
+	}
+
+	lines := []string{fmt.Sprintf("%d to create, %d to update, %d to delete, %d unchanged", len(created), len(updated), len(deleted), unchanged)}
+	for _, change := range []struct {
+		action string
+		keys   []string
+	}{{"create", created}, {"update", updated}, {"delete", deleted}} {
+		if len(change.keys) > 0 {
+			lines = append(lines, change.action+": "+strings.Join(change.keys, ", "))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,509 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/bitwarden/sdk-go/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func projectSecretsTestModel(projectId string, secrets map[string]projectSecretsResourceSecret) projectSecretsResourceModel {
+	return projectSecretsResourceModel{
+		ID:             types.StringUnknown(),
+		ProjectID:      types.StringValue(projectId),
+		OrganizationID: types.StringUnknown(),
+		Secrets:        secrets,
+		SecretIDs:      types.MapUnknown(types.StringType),
+	}
+}
+
+func projectSecret(value string, note string) projectSecretsResourceSecret {
+	return projectSecretsResourceSecret{Value: types.StringValue(value), Note: types.StringValue(note)}
+}
+
+func TestProjectSecretsResourceReconcile(t *testing.T) {
+	client := newMockBitwardenClient()
+	project := client.addProject(mockOrgId, "app")
+	unmanaged := client.addSecret("UNMANAGED", "keep", "", mockOrgId, project.ID)
+	r := &projectSecretsResource{bitwardenClient: client, organizationId: mockOrgId}
+	schema := resourceTestSchema(t, r)
+
+	createResp := fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: newTestPlan(t, schema, projectSecretsTestModel(project.ID, map[string]projectSecretsResourceSecret{
+		"API_KEY":     projectSecret("abc123", ""),
+		"DB_PASSWORD": projectSecret("secret", "rotated yearly"),
+		"OBSOLETE":    projectSecret("old", ""),
+	}))}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", createResp.Diagnostics)
+	}
+
+	var created projectSecretsResourceModel
+	createResp.State.Get(context.Background(), &created)
+	createdIds, _ := secretIdsFromValue(context.Background(), created.SecretIDs)
+	if len(createdIds) != 3 || client.secrets[createdIds["DB_PASSWORD"]].Note != "rotated yearly" {
+		t.Fatalf("unexpected secrets after create: %v", createdIds)
+	}
+
+	// API_KEY is changed, OBSOLETE is removed and NEW is added.
+	plan := projectSecretsTestModel(project.ID, map[string]projectSecretsResourceSecret{
+		"API_KEY":     projectSecret("def456", ""),
+		"DB_PASSWORD": projectSecret("secret", "rotated yearly"),
+		"NEW":         projectSecret("new", ""),
+	})
+	plan.ID = created.ID
+	plan.OrganizationID = created.OrganizationID
+	updateResp := fwresource.UpdateResponse{State: createResp.State}
+	updates := client.callCount("Secrets.Update")
+	r.Update(context.Background(), fwresource.UpdateRequest{State: createResp.State, Plan: newTestPlan(t, schema, plan)}, &updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", updateResp.Diagnostics)
+	}
+
+	var updated projectSecretsResourceModel
+	updateResp.State.Get(context.Background(), &updated)
+	updatedIds, _ := secretIdsFromValue(context.Background(), updated.SecretIDs)
+	if _, found := client.secrets[createdIds["OBSOLETE"]]; found {
+		t.Errorf("expected OBSOLETE to be deleted")
+	}
+	if updatedIds["API_KEY"] != createdIds["API_KEY"] || client.secrets[updatedIds["API_KEY"]].Value != "def456" {
+		t.Errorf("expected API_KEY to be updated in place, got: %v", updatedIds)
+	}
+	if client.secrets[updatedIds["NEW"]].Value != "new" {
+		t.Errorf("expected NEW to be created, got: %v", updatedIds)
+	}
+	if calls := client.callCount("Secrets.Update") - updates; calls != 1 {
+		t.Errorf("expected only the changed secret to be updated, got %d updates", calls)
+	}
+
+	// Changes outside of Terraform are read back.
+	if _, err := client.Secrets().Update(updatedIds["NEW"], "NEW", "changed", "", mockOrgId, []string{project.ID}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	client.Secrets().Delete([]string{updatedIds["DB_PASSWORD"]})
+	readResp := fwresource.ReadResponse{State: updateResp.State}
+	r.Read(context.Background(), fwresource.ReadRequest{State: updateResp.State}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", readResp.Diagnostics)
+	}
+
+	var read projectSecretsResourceModel
+	readResp.State.Get(context.Background(), &read)
+	if _, found := read.Secrets["DB_PASSWORD"]; found || read.Secrets["NEW"].Value.ValueString() != "changed" {
+		t.Errorf("unexpected secrets after read: %v", read.Secrets)
+	}
+
+	deleteResp := fwresource.DeleteResponse{State: readResp.State}
+	r.Delete(context.Background(), fwresource.DeleteRequest{State: readResp.State}, &deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", deleteResp.Diagnostics)
+	}
+	if len(client.secrets) != 1 || client.secrets[unmanaged.ID].Key != "UNMANAGED" {
+		t.Errorf("expected only the unmanaged secret to remain, got: %v", client.secrets)
+	}
+}
+
+func TestProjectSecretsResourceValidateConfigRedactsKey(t *testing.T) {
+	// ValidateConfig runs before the provider is configured, so redact_keys is not known yet.
+	r := &projectSecretsResource{}
+	schema := resourceTestSchema(t, r)
+	plan := newTestPlan(t, schema, projectSecretsTestModel(validProjectUUID, map[string]projectSecretsResourceSecret{
+		"DATABASE_PASSWORD\t": projectSecret("value", ""),
+	}))
+
+	resp := fwresource.ValidateConfigResponse{}
+	r.ValidateConfig(context.Background(), fwresource.ValidateConfigRequest{Config: tfsdk.Config{Schema: schema, Raw: plan.Raw}}, &resp)
+
+	errors := resp.Diagnostics.Errors()
+	if len(errors) != 1 || errors[0].Summary() != "Invalid Secret Key" {
+		t.Fatalf("expected an invalid key error, got: %v", resp.Diagnostics)
+	}
+	if strings.Contains(errors[0].Detail(), "DATABASE_PASSWORD") {
+		t.Errorf("expected the key to be redacted, got: %q", errors[0].Detail())
+	}
+	withPath, ok := errors[0].(diag.DiagnosticWithPath)
+	if !ok || strings.Contains(withPath.Path().String(), "DATABASE_PASSWORD") {
+		t.Errorf("expected the path not to contain the key, got: %v", errors[0])
+	}
+}
+
+func TestProjectSecretsResourceImportOrganizationMismatch(t *testing.T) {
+	client := newMockBitwardenClient()
+	project := client.addProject(validProjectUUID, "app")
+	client.addSecret("API_KEY", "abc123", "", validProjectUUID, project.ID)
+	r := &projectSecretsResource{bitwardenClient: client, organizationId: mockOrgId}
+	schema := resourceTestSchema(t, r)
+
+	importResp := fwresource.ImportStateResponse{State: tfsdk.State{Schema: schema, Raw: newTestState(t, schema, projectSecretsResourceModel{
+		ID:             types.StringNull(),
+		ProjectID:      types.StringNull(),
+		OrganizationID: types.StringNull(),
+		SecretIDs:      types.MapNull(types.StringType),
+	}).Raw}}
+	r.ImportState(context.Background(), fwresource.ImportStateRequest{ID: project.ID}, &importResp)
+	if !diagnosticsContain(importResp.Diagnostics, "Imported Project Belongs to Another Organization") {
+		t.Fatalf("expected an error about the organization of the project, got: %v", importResp.Diagnostics)
+	}
+
+	var state projectSecretsResourceModel
+	importResp.State.Get(context.Background(), &state)
+	if !state.ID.IsNull() {
+		t.Errorf("expected the project not to be imported, got: %v", state.ID)
+	}
+}
+
+func TestProjectSecretsResourceImport(t *testing.T) {
+	client := newMockBitwardenClient()
+	project := client.addProject(mockOrgId, "app")
+	secret := client.addSecret("API_KEY", "abc123", "note", mockOrgId, project.ID)
+	r := &projectSecretsResource{bitwardenClient: client, organizationId: mockOrgId}
+	schema := resourceTestSchema(t, r)
+
+	importResp := fwresource.ImportStateResponse{State: tfsdk.State{Schema: schema, Raw: newTestState(t, schema, projectSecretsResourceModel{
+		ID:             types.StringNull(),
+		ProjectID:      types.StringNull(),
+		OrganizationID: types.StringNull(),
+		SecretIDs:      types.MapNull(types.StringType),
+	}).Raw}}
+	r.ImportState(context.Background(), fwresource.ImportStateRequest{ID: project.ID}, &importResp)
+	if importResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", importResp.Diagnostics)
+	}
+
+	readResp := fwresource.ReadResponse{State: importResp.State}
+	r.Read(context.Background(), fwresource.ReadRequest{State: importResp.State}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", readResp.Diagnostics)
+	}
+
+	var state projectSecretsResourceModel
+	readResp.State.Get(context.Background(), &state)
+	ids, _ := secretIdsFromValue(context.Background(), state.SecretIDs)
+	if ids["API_KEY"] != secret.ID || state.Secrets["API_KEY"].Value.ValueString() != "abc123" {
+		t.Fatalf("unexpected state after import: %+v", state)
+	}
+
+	client.addSecret("API_KEY", "duplicate", "", mockOrgId, project.ID)
+	readResp = fwresource.ReadResponse{State: importResp.State}
+	r.Read(context.Background(), fwresource.ReadRequest{State: importResp.State}, &readResp)
+	if !diagnosticsContain(readResp.Diagnostics, "Duplicated Secret Key") {
+		t.Fatalf("expected duplicated key error, got: %v", readResp.Diagnostics)
+	}
+}
+
+func TestProjectSecretsResourceModifyPlanKeepsIds(t *testing.T) {
+	r := &projectSecretsResource{}
+	schema := resourceTestSchema(t, r)
+
+	stateModel := projectSecretsTestModel(validProjectUUID, map[string]projectSecretsResourceSecret{"KEPT": projectSecret("value", "")})
+	stateModel.ID = types.StringValue(validProjectUUID)
+	stateModel.OrganizationID = types.StringValue(mockOrgId)
+	stateModel.SecretIDs = secretIdsValue(map[string]string{"KEPT": validProjectUUID})
+	planModel := projectSecretsTestModel(validProjectUUID, map[string]projectSecretsResourceSecret{
+		"KEPT":  projectSecret("changed", ""),
+		"ADDED": projectSecret("value", ""),
+	})
+
+	plan := newTestPlan(t, schema, planModel)
+	resp := fwresource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(context.Background(), fwresource.ModifyPlanRequest{Plan: plan, State: newTestState(t, schema, stateModel)}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var planned projectSecretsResourceModel
+	resp.Plan.Get(context.Background(), &planned)
+	elements := planned.SecretIDs.Elements()
+	if !elements["KEPT"].Equal(types.StringValue(validProjectUUID)) || !elements["ADDED"].IsUnknown() {
+		t.Fatalf("unexpected planned secret_ids: %v", planned.SecretIDs)
+	}
+}
+
+func TestProjectSecretsResourceChangeSummary(t *testing.T) {
+	client := newMockBitwardenClient()
+	project := client.addProject(mockOrgId, "app")
+	r := &projectSecretsResource{bitwardenClient: client, organizationId: mockOrgId}
+	schema := resourceTestSchema(t, r)
+
+	// The summary of a new resource is determined by the apply if it was not planned.
+	createModel := projectSecretsTestModel(project.ID, map[string]projectSecretsResourceSecret{
+		"KEPT":    projectSecret("value", ""),
+		"CHANGED": projectSecret("value", ""),
+		"REMOVED": projectSecret("value", ""),
+	})
+	createModel.ChangeSummary = types.StringUnknown()
+	createResp := fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	newTestPrivateState(&createResp.Private)
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: newTestPlan(t, schema, createModel)}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", createResp.Diagnostics)
+	}
+	var state projectSecretsResourceModel
+	createResp.State.Get(context.Background(), &state)
+	if expected := "3 to create, 0 to update, 0 to delete, 0 unchanged\ncreate: CHANGED, KEPT, REMOVED"; state.ChangeSummary.ValueString() != expected {
+		t.Fatalf("expected the summary %q, got: %q", expected, state.ChangeSummary.ValueString())
+	}
+
+	tests := map[string]struct {
+		secrets  map[string]projectSecretsResourceSecret
+		expected string
+	}{
+		"secrets changed": {
+			secrets: map[string]projectSecretsResourceSecret{
+				"KEPT":    projectSecret("value", ""),
+				"CHANGED": projectSecret("s3cr3t", ""),
+				"ADDED":   projectSecret("value", ""),
+			},
+			expected: "1 to create, 1 to update, 1 to delete, 1 unchanged\ncreate: ADDED\nupdate: CHANGED\ndelete: REMOVED",
+		},
+		"secrets unchanged": {
+			secrets:  state.Secrets,
+			expected: state.ChangeSummary.ValueString(),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			planModel := projectSecretsTestModel(project.ID, test.secrets)
+			planModel.ChangeSummary = types.StringUnknown()
+			plan := newTestPlan(t, schema, planModel)
+			resp := fwresource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(context.Background(), fwresource.ModifyPlanRequest{Plan: plan, State: createResp.State}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var planned projectSecretsResourceModel
+			resp.Plan.Get(context.Background(), &planned)
+			if planned.ChangeSummary.ValueString() != test.expected {
+				t.Fatalf("expected the summary %q, got: %q", test.expected, planned.ChangeSummary.ValueString())
+			}
+			if strings.Contains(planned.ChangeSummary.ValueString(), "s3cr3t") {
+				t.Fatalf("expected no values in the summary, got: %q", planned.ChangeSummary.ValueString())
+			}
+		})
+	}
+}
+
+func TestProjectSecretsResourcePartialFailures(t *testing.T) {
+	client := newMockBitwardenClient()
+	project := client.addProject(mockOrgId, "app")
+	r := &projectSecretsResource{bitwardenClient: client, organizationId: mockOrgId}
+	schema := resourceTestSchema(t, r)
+
+	// failedOperations returns the summary of every error keyed by the secret key it is reported on.
+	failedOperations := func(diags diag.Diagnostics) map[string]string {
+		failed := map[string]string{}
+		for _, d := range diags.Errors() {
+			withPath, ok := d.(diag.DiagnosticWithPath)
+			if !ok {
+				t.Fatalf("expected every error to be reported on a secret, got: %v", d)
+			}
+			failed[withPath.Path().String()] = d.Summary()
+		}
+		return failed
+	}
+
+	client.secretCreateHook = func(key, value, note string, organizationID string, projectIDs []string) (*sdk.SecretResponse, error) {
+		if key == "B" {
+			return nil, fmt.Errorf("API error: [400 Bad Request]")
+		}
+		secret := client.addSecret(key, value, note, organizationID, projectIDs[0])
+		return &secret, nil
+	}
+	planModel := projectSecretsTestModel(project.ID, map[string]projectSecretsResourceSecret{
+		"A": projectSecret("a", ""),
+		"B": projectSecret("b", ""),
+		"C": projectSecret("c", ""),
+		"D": projectSecret("d", ""),
+	})
+	createResp := fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	newTestPrivateState(&createResp.Private)
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: newTestPlan(t, schema, planModel)}, &createResp)
+	if failed := failedOperations(createResp.Diagnostics); !maps.Equal(failed, map[string]string{`secrets["B"]`: "Unable to Create Secret"}) {
+		t.Fatalf("expected only the creation of B to fail, got: %v", failed)
+	}
+
+	var created projectSecretsResourceModel
+	createResp.State.Get(context.Background(), &created)
+	if !slices.Equal(sortedKeys(created.Secrets), []string{"A", "C", "D"}) {
+		t.Fatalf("expected the remaining secrets to be created, got: %v", created.Secrets)
+	}
+	// A failed key is not recorded as the progress of an interrupted apply.
+	if progress, _ := storedApplyProgress(context.Background(), createResp.Private); progress != nil {
+		t.Fatalf("expected no apply progress, got: %+v", progress)
+	}
+
+	// The next apply retries B, fails to update A and to delete C, and still updates D.
+	createdIds, _ := secretIdsFromValue(context.Background(), created.SecretIDs)
+	client.secretCreateHook = nil
+	client.secretUpdateHook = func(secretID string, key, value, note string, organizationID string, projectIDs []string) (*sdk.SecretResponse, error) {
+		if key == "A" {
+			return nil, fmt.Errorf("API error: [500 Internal Server Error]")
+		}
+		secret := client.secrets[secretID]
+		secret.Value = value
+		client.secrets[secretID] = secret
+		return &secret, nil
+	}
+	delete(client.secrets, createdIds["C"])
+	planModel = projectSecretsTestModel(project.ID, map[string]projectSecretsResourceSecret{
+		"A": projectSecret("a2", ""),
+		"B": projectSecret("b", ""),
+		"D": projectSecret("d2", ""),
+	})
+	updateResp := fwresource.UpdateResponse{State: createResp.State, Private: createResp.Private}
+	r.Update(context.Background(), fwresource.UpdateRequest{State: createResp.State, Plan: newTestPlan(t, schema, planModel), Private: createResp.Private}, &updateResp)
+	expected := map[string]string{`secrets["A"]`: "Unable to Update Secret", `secrets["C"]`: "Unable to Delete Secret"}
+	if failed := failedOperations(updateResp.Diagnostics); !maps.Equal(failed, expected) {
+		t.Fatalf("expected the update of A and the deletion of C to fail, got: %v", failed)
+	}
+	if !diagnosticsContain(updateResp.Diagnostics, `key "C"`) {
+		t.Errorf("expected the deletion error to name the key, got: %v", updateResp.Diagnostics)
+	}
+
+	var updated projectSecretsResourceModel
+	updateResp.State.Get(context.Background(), &updated)
+	if updated.Secrets["A"].Value.ValueString() != "a" || updated.Secrets["D"].Value.ValueString() != "d2" {
+		t.Errorf("expected only D to be updated, got: %v", updated.Secrets)
+	}
+	if _, found := updated.Secrets["B"]; !found {
+		t.Errorf("expected B to be created, got: %v", updated.Secrets)
+	}
+	if _, found := updated.Secrets["C"]; !found {
+		t.Errorf("expected C to be kept in the state, got: %v", updated.Secrets)
+	}
+}
+
+func TestProjectSecretsResourceResumesInterruptedApply(t *testing.T) {
+	client := newMockBitwardenClient()
+	project := client.addProject(mockOrgId, "app")
+	r := &projectSecretsResource{bitwardenClient: client, organizationId: mockOrgId}
+	schema := resourceTestSchema(t, r)
+
+	// The apply is interrupted while the first secret is created.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client.secretCreateHook = func(key, value, note string, organizationID string, projectIDs []string) (*sdk.SecretResponse, error) {
+		cancel()
+		secret := client.addSecret(key, value, note, organizationID, projectIDs[0])
+		return &secret, nil
+	}
+
+	planModel := projectSecretsTestModel(project.ID, map[string]projectSecretsResourceSecret{
+		"A": projectSecret("a", ""),
+		"B": projectSecret("b", ""),
+		"C": projectSecret("c", ""),
+	})
+	createResp := fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	newTestPrivateState(&createResp.Private)
+	r.Create(ctx, fwresource.CreateRequest{Plan: newTestPlan(t, schema, planModel)}, &createResp)
+	if !diagnosticsContain(createResp.Diagnostics, "Apply Interrupted") {
+		t.Fatalf("expected interrupted error, got: %v", createResp.Diagnostics)
+	}
+
+	var created projectSecretsResourceModel
+	createResp.State.Get(context.Background(), &created)
+	if len(created.Secrets) != 1 || client.callCount("Secrets.Create") != 1 {
+		t.Fatalf("expected only the first secret to be created, got: %v", created.Secrets)
+	}
+	progress, _ := storedApplyProgress(context.Background(), createResp.Private)
+	if progress == nil || !slices.Equal(progress.Done, []string{"A"}) || !slices.Equal(progress.Pending, []string{"B", "C"}) {
+		t.Fatalf("unexpected progress: %+v", progress)
+	}
+
+	// The next apply reports the resumption and only applies the remaining secrets.
+	client.secretCreateHook = nil
+	planModel.ID = created.ID
+	planModel.OrganizationID = created.OrganizationID
+	plan := newTestPlan(t, schema, planModel)
+	planResp := fwresource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(context.Background(), fwresource.ModifyPlanRequest{Plan: plan, State: createResp.State, Private: createResp.Private}, &planResp)
+	if !diagnosticsContain(planResp.Diagnostics, "Resuming Interrupted Apply") {
+		t.Errorf("expected resumption warning, got: %v", planResp.Diagnostics)
+	}
+
+	updateResp := fwresource.UpdateResponse{State: createResp.State, Private: createResp.Private}
+	r.Update(context.Background(), fwresource.UpdateRequest{State: createResp.State, Plan: planResp.Plan, Private: createResp.Private}, &updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", updateResp.Diagnostics)
+	}
+
+	var updated projectSecretsResourceModel
+	updateResp.State.Get(context.Background(), &updated)
+	if len(updated.Secrets) != 3 || client.callCount("Secrets.Create") != 3 || client.callCount("Secrets.Update") != 0 {
+		t.Fatalf("expected the remaining secrets to be created, got: %v", updated.Secrets)
+	}
+	if progress, _ := storedApplyProgress(context.Background(), updateResp.Private); progress != nil {
+		t.Errorf("expected the progress to be cleared, got: %+v", progress)
+	}
+}
+
+func TestProjectSecretsResourceImportDuplicatedKeys(t *testing.T) {
+	client := newMockBitwardenClient()
+	project := client.addProject(mockOrgId, "app")
+	older := client.addSecret("API_KEY", "old", "", mockOrgId, project.ID)
+	newer := client.addSecret("API_KEY", "new", "", mockOrgId, project.ID)
+	newer.RevisionDate = older.RevisionDate.Add(time.Hour)
+	client.secrets[newer.ID] = newer
+	r := &projectSecretsResource{bitwardenClient: client, organizationId: mockOrgId}
+	schema := resourceTestSchema(t, r)
+
+	tests := map[string]struct {
+		importId   string
+		expectId   string
+		expectDiag string
+	}{
+		"fail by default": {importId: project.ID, expectDiag: "Duplicated Secret Key"},
+		"fail":            {importId: project.ID + ":fail", expectDiag: "Duplicated Secret Key"},
+		"newest":          {importId: project.ID + ":newest", expectId: newer.ID, expectDiag: "Duplicated Secret Keys Imported"},
+		"oldest":          {importId: project.ID + ":oldest", expectId: older.ID, expectDiag: "Duplicated Secret Keys Imported"},
+		"invalid":         {importId: project.ID + ":latest", expectDiag: "Invalid Import ID"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			importResp := fwresource.ImportStateResponse{State: tfsdk.State{Schema: schema, Raw: newTestState(t, schema, projectSecretsResourceModel{
+				ID:             types.StringNull(),
+				ProjectID:      types.StringNull(),
+				OrganizationID: types.StringNull(),
+				SecretIDs:      types.MapNull(types.StringType),
+			}).Raw}}
+			newTestPrivateState(&importResp.Private)
+			r.ImportState(context.Background(), fwresource.ImportStateRequest{ID: test.importId}, &importResp)
+			if importResp.Diagnostics.HasError() {
+				if !diagnosticsContain(importResp.Diagnostics, test.expectDiag) {
+					t.Fatalf("expected %s, got: %v", test.expectDiag, importResp.Diagnostics)
+				}
+				return
+			}
+
+			readResp := fwresource.ReadResponse{State: importResp.State, Private: importResp.Private}
+			r.Read(context.Background(), fwresource.ReadRequest{State: importResp.State, Private: importResp.Private}, &readResp)
+			if !diagnosticsContain(readResp.Diagnostics, test.expectDiag) {
+				t.Fatalf("expected %s, got: %v", test.expectDiag, readResp.Diagnostics)
+			}
+			if test.expectId == "" {
+				return
+			}
+
+			var state projectSecretsResourceModel
+			readResp.State.Get(context.Background(), &state)
+			ids, _ := secretIdsFromValue(context.Background(), state.SecretIDs)
+			if ids["API_KEY"] != test.expectId {
+				t.Errorf("expected secret %s to be imported, got: %v", test.expectId, ids)
+			}
+			if option, _ := readResp.Private.GetKey(context.Background(), onDuplicateKeyPrivateKey); option != nil {
+				t.Errorf("expected the import option to be cleared, got: %s", option)
+			}
+		})
+	}
+}
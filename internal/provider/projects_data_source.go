@@ -1,12 +1,15 @@
 package provider
 
 import (
+	"cmp"
 	"context"
-	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/bitwarden/sdk-go/v2"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -25,11 +28,15 @@ func NewProjectsDataSource() datasource.DataSource {
 type projectsDataSource struct {
 	bitwardenClient sdk.BitwardenClientInterface
 	organizationId  string
+	verboseErrors   bool
+	logLevel        string
 }
 
 // projectsDataSourceModel describes the data source data model.
 type projectsDataSourceModel struct {
-	Projects []projectDataSourceModel `tfsdk:"projects"`
+	OrganizationID      types.String             `tfsdk:"organization_id"`
+	PreserveServerOrder types.Bool               `tfsdk:"preserve_server_order"`
+	Projects            []projectDataSourceModel `tfsdk:"projects"`
 }
 
 type projectDataSourceModel struct {
@@ -49,6 +56,22 @@ func (d *projectsDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 		Description:         "The projects data source fetches all projects accessible by the used machine account.",
 		MarkdownDescription: "The `projects` data source fetches all projects accessible by the used machine account.",
 		Attributes: map[string]schema.Attribute{
+			"organization_id": schema.StringAttribute{
+				Description:         "String representation of the ID of the organization whose projects are fetched. Overrides the organization configured on the provider.",
+				MarkdownDescription: "String representation of the `ID` of the organization whose projects are fetched. Overrides the `organization_id` configured on the provider.",
+				Computed:            true,
+				Optional:            true,
+				Validators: []validator.String{
+					stringUUIDValidate(),
+				},
+			},
+			"preserve_server_order": schema.BoolAttribute{
+				Description: "When set to true, the projects are returned in the order of the Bitwarden Secrets Manager API, which may change between refreshes. " +
+					"Otherwise, the projects are sorted by name and then by ID. The provided default is false.",
+				MarkdownDescription: "When set to `true`, the projects are returned in the order of the Bitwarden Secrets Manager API, which may change between refreshes. " +
+					"Otherwise, the projects are sorted by `name` and then by `ID`. The provided default is `false`.",
+				Optional: true,
+			},
 			"projects": schema.ListNestedAttribute{
 				Description: "Nested list of all fetched projects.",
 				Computed:    true,
@@ -85,85 +108,87 @@ func (d *projectsDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 }
 
 func (d *projectsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	// Add a nil check when handling BitwardenSecretsManagerProviderDataStruct because Terraform
-	// sets that data after it calls the ConfigureProvider RPC.
-	tflog.Info(ctx, "Configuring Datasource")
-	if req.ProviderData == nil {
-		tflog.Debug(ctx, "Skipping Datasource Configuration because Provider has not been configured yet.")
+	providerDataStruct, ok := configureClient(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
 		return
 	}
+	ctx = newLogContext(ctx, providerDataStruct.logLevel)
+	tflog.SubsystemInfo(ctx, logSubsystem, "Configuring Datasource")
 
-	providerDataStruct, ok := req.ProviderData.(BitwardenSecretsManagerProviderDataStruct)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected sdk.BitwardenClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
+	d.bitwardenClient = providerDataStruct.bitwardenClient
+	d.organizationId = providerDataStruct.organizationId
+	d.verboseErrors = providerDataStruct.verboseErrors
+	d.logLevel = providerDataStruct.logLevel
+
+	tflog.SubsystemInfo(ctx, logSubsystem, "Datasource Configured")
+}
 
+func (d *projectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, correlationId := newCorrelationContext(ctx, d.logLevel)
+	defer appendCorrelationId(&resp.Diagnostics, correlationId)
+
+	tflog.SubsystemInfo(ctx, logSubsystem, "Reading Projects Datasource")
+
+	var state projectsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	client := providerDataStruct.bitwardenClient
-	organizationId := providerDataStruct.organizationId
+	organizationId := resolveOrganizationId(state.OrganizationID, d.organizationId)
+	defer recoverFromPanic(ctx, "List Projects", organizationId, &resp.Diagnostics)
 
-	if client == nil {
+	if d.bitwardenClient == nil {
 		resp.Diagnostics.AddError(
 			"Client Not Initialized",
-			"The Bitwarden client was not properly initialized due to a missing Bitwarden API Client.",
+			"The Bitwarden client was not properly initialized.",
 		)
 		return
 	}
 
-	if organizationId == "" {
-		resp.Diagnostics.AddError(
-			"Client Not Initialized",
-			"The Bitwarden client was not properly initialized due to an empty Organization ID.",
-		)
+	if !checkContext(ctx, "List Projects", &resp.Diagnostics) {
 		return
 	}
 
-	d.bitwardenClient = client
-	d.organizationId = organizationId
-
-	tflog.Info(ctx, "Datasource Configured")
-}
-
-func (d *projectsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
-	tflog.Info(ctx, "Reading Projects Datasource")
-
-	var state projectsDataSourceModel
-
-	if d.bitwardenClient == nil {
+	projects, err := d.bitwardenClient.Projects().List(organizationId)
+	if err != nil {
 		resp.Diagnostics.AddError(
-			"Client Not Initialized",
-			"The Bitwarden client was not properly initialized.",
+			"Unable to List Projects",
+			sdkErrorDetail(err, organizationId, d.verboseErrors),
 		)
 		return
 	}
 
-	projects, err := d.bitwardenClient.Projects().List(d.organizationId)
-	if err != nil {
+	if projects == nil {
 		resp.Diagnostics.AddError(
-			"Unable to List Projects",
-			err.Error(),
+			"Unexpected Bitwarden Secrets Manager Response",
+			"The Bitwarden Secrets Manager API returned an empty response when listing projects.",
 		)
 		return
 	}
 
+	state.OrganizationID = types.StringValue(organizationId)
 	for _, project := range projects.Data {
 		projectState := projectDataSourceModel{
 			ID:             types.StringValue(project.ID),
 			Name:           types.StringValue(project.Name),
 			OrganizationID: types.StringValue(project.OrganizationID),
-			CreationDate:   types.StringValue(project.CreationDate.String()),
-			RevisionDate:   types.StringValue(project.RevisionDate.String()),
+			CreationDate:   timestampValue(project.CreationDate),
+			RevisionDate:   timestampValue(project.RevisionDate),
 		}
 
 		state.Projects = append(state.Projects, projectState)
 	}
 
+	if !state.PreserveServerOrder.ValueBool() {
+		slices.SortStableFunc(state.Projects, func(a, b projectDataSourceModel) int {
+			return cmp.Or(strings.Compare(a.Name.ValueString(), b.Name.ValueString()), strings.Compare(a.ID.ValueString(), b.ID.ValueString()))
+		})
+	}
+
 	// Set state
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -1,9 +1,15 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"github.com/bitwarden/sdk-go/v2"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"slices"
 	"strconv"
 	"testing"
 )
@@ -61,6 +67,40 @@ func TestAccDatasourceProjectsListOneProject(t *testing.T) {
 	})
 }
 
+func TestAccDatasourceProjectsListCreatedProject(t *testing.T) {
+	var projectId string
+	projectName := "Test-Project-Replay"
+	bitwardenClient, organizationId, providerConfig := newAccTestClient(t, "project_create")
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			project, preCheckErr := bitwardenClient.Projects().Create(organizationId, projectName)
+			if preCheckErr != nil {
+				t.Fatal("Error creating test project for provider validation.")
+			}
+			projectId = project.ID
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+                       data "bitwarden-secrets_projects" "test" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					func(s *terraform.State) error {
+						return testAccCheckIfProjectExistsInOutput(projectId, projectName)(s)
+					},
+				),
+			},
+		},
+		CheckDestroy: func(state *terraform.State) error {
+			_, cleanUpErr := bitwardenClient.Projects().Delete([]string{projectId})
+			if cleanUpErr != nil {
+				t.Fatalf("Error cleaning up test project: %s", cleanUpErr)
+			}
+			return nil
+		},
+	})
+}
+
 func TestAccDatasourceProjectsListTwoProject(t *testing.T) {
 	var projectId1, projectId2 string
 	projectName1 := "Test-Project-" + generateRandomString()
@@ -138,3 +178,116 @@ func testAccCheckIfProjectExistsInOutput(projectId, projectName string) resource
 		return fmt.Errorf("project with the ID: %s does not exist\n", projectId)
 	}
 }
+
+func TestProjectsDataSourceReadWithEmptyResponse(t *testing.T) {
+	client := newMockBitwardenClient()
+	client.projectListHook = func(organizationID string) (*sdk.ProjectsResponse, error) {
+		return nil, nil
+	}
+	d := &projectsDataSource{bitwardenClient: client, organizationId: mockOrgId}
+	schema := dataSourceTestSchema(t, d)
+
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: schema}}
+	d.Read(context.Background(), datasource.ReadRequest{Config: newTestConfig(t, schema, projectsDataSourceModel{})}, &resp)
+
+	if !diagnosticsContain(resp.Diagnostics, "Unexpected Bitwarden Secrets Manager Response") {
+		t.Fatalf("expected diagnostic about unexpected response, got: %v", resp.Diagnostics)
+	}
+}
+
+func TestProjectsDataSourceReadWithOrganizationOverride(t *testing.T) {
+	const otherOrgId = "2a1d2c4e-6f7b-4b8e-9c1d-3e5f7a9b1c2d"
+	client := newMockBitwardenClient()
+	client.addProject(mockOrgId, "default")
+	otherProject := client.addProject(otherOrgId, "other")
+
+	tests := map[string]struct {
+		organizationId   types.String
+		expectedOrgId    string
+		expectedProjects []string
+	}{
+		"provider organization": {organizationId: types.StringNull(), expectedOrgId: mockOrgId, expectedProjects: []string{"default"}},
+		"override":              {organizationId: types.StringValue(otherOrgId), expectedOrgId: otherOrgId, expectedProjects: []string{otherProject.Name}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			d := &projectsDataSource{bitwardenClient: client, organizationId: mockOrgId}
+			schema := dataSourceTestSchema(t, d)
+
+			resp := datasource.ReadResponse{State: tfsdk.State{Schema: schema}}
+			d.Read(context.Background(), datasource.ReadRequest{Config: newTestConfig(t, schema, projectsDataSourceModel{OrganizationID: test.organizationId})}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var state projectsDataSourceModel
+			resp.State.Get(context.Background(), &state)
+			if state.OrganizationID.ValueString() != test.expectedOrgId {
+				t.Errorf("expected organization_id %s, got: %s", test.expectedOrgId, state.OrganizationID.ValueString())
+			}
+			var names []string
+			for _, project := range state.Projects {
+				names = append(names, project.Name.ValueString())
+			}
+			if !slices.Equal(names, test.expectedProjects) {
+				t.Errorf("expected projects %v, got: %v", test.expectedProjects, names)
+			}
+		})
+	}
+}
+
+func TestProjectsDataSourceReadSortsProjects(t *testing.T) {
+	client := newMockBitwardenClient()
+	projectB := client.addProject(mockOrgId, "b")
+	projectA1 := client.addProject(mockOrgId, "a")
+	projectA2 := client.addProject(mockOrgId, "a")
+	serverOrder := []string{projectB.ID, projectA2.ID, projectA1.ID}
+	client.projectListHook = func(organizationID string) (*sdk.ProjectsResponse, error) {
+		response := sdk.ProjectsResponse{}
+		for _, id := range serverOrder {
+			response.Data = append(response.Data, client.projects[id])
+		}
+		return &response, nil
+	}
+
+	sortedIds := []string{projectA1.ID, projectA2.ID}
+	if projectA2.ID < projectA1.ID {
+		sortedIds = []string{projectA2.ID, projectA1.ID}
+	}
+	sortedIds = append(sortedIds, projectB.ID)
+
+	tests := map[string]struct {
+		preserveServerOrder types.Bool
+		expectedIds         []string
+	}{
+		"sorted by name then id": {preserveServerOrder: types.BoolNull(), expectedIds: sortedIds},
+		"server order":           {preserveServerOrder: types.BoolValue(true), expectedIds: serverOrder},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			d := &projectsDataSource{bitwardenClient: client, organizationId: mockOrgId}
+			schema := dataSourceTestSchema(t, d)
+
+			resp := datasource.ReadResponse{State: tfsdk.State{Schema: schema}}
+			d.Read(context.Background(), datasource.ReadRequest{Config: newTestConfig(t, schema, projectsDataSourceModel{
+				OrganizationID:      types.StringNull(),
+				PreserveServerOrder: test.preserveServerOrder,
+			})}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var state projectsDataSourceModel
+			resp.State.Get(context.Background(), &state)
+			var ids []string
+			for _, project := range state.Projects {
+				ids = append(ids, project.ID.ValueString())
+			}
+			if !slices.Equal(ids, test.expectedIds) {
+				t.Errorf("expected projects %v, got: %v", test.expectedIds, ids)
+			}
+		})
+	}
+}
@@ -2,10 +2,22 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
 	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/bitwarden/sdk-go/v2"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -18,9 +30,29 @@ import (
 
 var (
 	// Ensure BitwardenSecretsManagerProvider satisfies various provider interfaces.
-	_         provider.Provider              = &BitwardenSecretsManagerProvider{}
-	_         provider.ProviderWithFunctions = &BitwardenSecretsManagerProvider{}
-	statePath                                = ".bw-provider-state"
+	_ provider.Provider                   = &BitwardenSecretsManagerProvider{}
+	_ provider.ProviderWithFunctions      = &BitwardenSecretsManagerProvider{}
+	_ provider.ProviderWithValidateConfig = &BitwardenSecretsManagerProvider{}
+
+	// createBitwardenClient creates the client of a configured provider. Every provider instance, e.g. every alias,
+	// gets its own client.
+	createBitwardenClient = func(apiUrl *string, identityUrl *string) (sdk.BitwardenClientInterface, error) {
+		return sdk.NewBitwardenClient(apiUrl, identityUrl)
+	}
+
+	// regionEndpoints maps the regions of the Bitwarden cloud to their API and IDENTITY endpoints.
+	regionEndpoints = map[string]struct {
+		apiUrl      string
+		identityUrl string
+	}{
+		"us": {apiUrl: "https://api.bitwarden.com", identityUrl: "https://identity.bitwarden.com"},
+		"eu": {apiUrl: "https://api.bitwarden.eu", identityUrl: "https://identity.bitwarden.eu"},
+	}
+
+	// configureRetryBackoff returns the delay before the given retry of the authentication during Configure.
+	configureRetryBackoff = func(retry int64) time.Duration {
+		return min(time.Second<<(retry-1), 30*time.Second)
+	}
 )
 
 // BitwardenSecretsManagerProvider defines the provider implementation.
@@ -33,10 +65,29 @@ type BitwardenSecretsManagerProvider struct {
 
 // BitwardenSecretsManagerProviderModel describes the provider data model.
 type BitwardenSecretsManagerProviderModel struct {
-	ApiUrl         types.String `tfsdk:"api_url"`
-	IdentityUrl    types.String `tfsdk:"identity_url"`
-	AccessToken    types.String `tfsdk:"access_token"`
-	OrganizationId types.String `tfsdk:"organization_id"`
+	ApiUrl                      types.String `tfsdk:"api_url"`
+	IdentityUrl                 types.String `tfsdk:"identity_url"`
+	Region                      types.String `tfsdk:"region"`
+	AccessToken                 types.String `tfsdk:"access_token"`
+	OrganizationId              types.String `tfsdk:"organization_id"`
+	IgnoreMissingOnDelete       types.Bool   `tfsdk:"ignore_missing_on_delete"`
+	VerboseErrors               types.Bool   `tfsdk:"verbose_errors"`
+	WarnValueInState            types.Bool   `tfsdk:"warn_value_in_state"`
+	ValidateProjectOrganization types.Bool   `tfsdk:"validate_project_organization"`
+	MetadataOnly                types.Bool   `tfsdk:"metadata_only"`
+	EnableProjectCache          types.Bool   `tfsdk:"enable_project_cache"`
+	MinServerVersion            types.String `tfsdk:"min_server_version"`
+	ManagedMarker               types.String `tfsdk:"managed_marker"`
+	ConfigureRetries            types.Int64  `tfsdk:"configure_retries"`
+	RetryBudgetSeconds          types.Int64  `tfsdk:"retry_budget_seconds"`
+	RefreshTtlSeconds           types.Int64  `tfsdk:"refresh_ttl_seconds"`
+	RedactKeys                  types.Bool   `tfsdk:"redact_keys"`
+	TruncateTimestamps          types.Bool   `tfsdk:"truncate_timestamps"`
+	LogTimings                  types.Bool   `tfsdk:"log_timings"`
+	LogSummary                  types.Bool   `tfsdk:"log_summary"`
+	Profile                     types.String `tfsdk:"profile"`
+	LogLevel                    types.String `tfsdk:"log_level"`
+	ProfileFile                 types.String `tfsdk:"profile_file"`
 }
 
 func (p *BitwardenSecretsManagerProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -45,8 +96,69 @@ func (p *BitwardenSecretsManagerProvider) Metadata(_ context.Context, _ provider
 }
 
 type BitwardenSecretsManagerProviderDataStruct struct {
-	bitwardenClient sdk.BitwardenClientInterface
-	organizationId  string
+	// bitwardenClient is shared by all resources and data sources, which Terraform operates on concurrently. The
+	// Bitwarden SDK is safe for concurrent use since v1.0.0 (https://github.com/bitwarden/sdk/pull/981): the Go client
+	// only serializes commands, and the underlying Rust client synchronizes its own state. The wrappers applied in
+	// Configure and the caches of the provider data guard their state with a mutex, so no additional locking is needed.
+	bitwardenClient             sdk.BitwardenClientInterface
+	organizationId              string
+	ignoreMissingOnDelete       bool
+	verboseErrors               bool
+	redactKeys                  bool
+	logLevel                    string
+	refreshTtl                  time.Duration
+	summary                     *operationSummary
+	warnValueInState            bool
+	validateProjectOrganization bool
+	metadataOnly                bool
+	projectCache                *projectCache
+}
+
+// configureClient validates the provider data handed to the Configure method of resources and data sources.
+// It returns false if the provider has not been configured yet or the provider data is invalid.
+func configureClient(ctx context.Context, providerData any, diags *diag.Diagnostics) (BitwardenSecretsManagerProviderDataStruct, bool) {
+	// Add a nil check when handling BitwardenSecretsManagerProviderDataStruct because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if providerData == nil {
+		tflog.Debug(ctx, "Skipping Configuration because Provider has not been configured yet.")
+		return BitwardenSecretsManagerProviderDataStruct{}, false
+	}
+
+	providerDataStruct, ok := providerData.(BitwardenSecretsManagerProviderDataStruct)
+	if !ok {
+		diags.AddError(
+			"Unexpected Configure Type",
+			fmt.Sprintf("Expected BitwardenSecretsManagerProviderDataStruct, got: %T. Please report this issue to the provider developers.", providerData),
+		)
+		return BitwardenSecretsManagerProviderDataStruct{}, false
+	}
+
+	if providerDataStruct.bitwardenClient == nil {
+		diags.AddError(
+			"Client Not Initialized",
+			"The Bitwarden client was not properly initialized due to a missing Bitwarden API Client.",
+		)
+		return BitwardenSecretsManagerProviderDataStruct{}, false
+	}
+
+	if providerDataStruct.organizationId == "" {
+		diags.AddError(
+			"Client Not Initialized",
+			"The Bitwarden client was not properly initialized due to an empty Organization ID.",
+		)
+		return BitwardenSecretsManagerProviderDataStruct{}, false
+	}
+
+	return providerDataStruct, true
+}
+
+// resolveOrganizationId returns the organization ID configured on a resource or data source if present,
+// falling back to the organization ID configured on the provider.
+func resolveOrganizationId(override types.String, defaultOrganizationId string) string {
+	if override.IsNull() || override.IsUnknown() || override.ValueString() == "" {
+		return defaultOrganizationId
+	}
+	return override.ValueString()
 }
 
 func (p *BitwardenSecretsManagerProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
@@ -58,21 +170,35 @@ func (p *BitwardenSecretsManagerProvider) Schema(_ context.Context, _ provider.S
 			"api_url": schema.StringAttribute{
 				Description: "URI for the Bitwarden Secrets Manager API endpoint. " +
 					"This configuration value is optional because it can also be provided via BW_API_URL environment variable. " +
-					"However, it **must be provided** in one of these two ways.",
+					"However, it **must be provided** in one of these two ways. Trailing slashes are removed and https is assumed if no scheme is given.",
 				MarkdownDescription: "URI for the **Bitwarden Secrets Manager** `API` endpoint. " +
 					"This configuration value is _**optional**_ because it can also be provided via `BW_API_URL` environment variable.  " +
-					"However, it **must be provided** in one of these two ways.",
+					"However, it **must be provided** in one of these two ways. Trailing slashes are removed and `https` is assumed if no scheme is given.",
 				Optional: true,
 			},
 			"identity_url": schema.StringAttribute{
 				Description: "URI for the Bitwarden Secrets Manager IDENTITY endpoint. " +
 					"This configuration value is optional because it can also be provided via BW_IDENTITY_API_URL environment variable. " +
-					"However, it **must be provided** in one of these two ways.",
+					"However, it **must be provided** in one of these two ways. Trailing slashes are removed and https is assumed if no scheme is given.",
 				MarkdownDescription: "URI for the **Bitwarden Secrets Manager** `IDENTITY` endpoint. " +
 					"This configuration value is _**optional**_ because it can also be provided via `BW_IDENTITY_API_URL` environment variable. " +
-					"However, it **must be provided** in one of these two ways.",
+					"However, it **must be provided** in one of these two ways. Trailing slashes are removed and `https` is assumed if no scheme is given.",
 				Optional: true,
 			},
+			"region": schema.StringAttribute{
+				Description: "The region of the Bitwarden cloud in which the organization is hosted. Must be one of us, eu or self-hosted. " +
+					"The regions us and eu select the API and IDENTITY endpoints of the region, which override the environment variables and the profile, and conflict with api_url and identity_url. " +
+					"The region self-hosted requires the endpoints to be provided by api_url and identity_url, the environment variables or the profile. " +
+					"By default, the endpoints must be provided like for self-hosted.",
+				MarkdownDescription: "The region of the Bitwarden cloud in which the organization is hosted. Must be one of `us`, `eu` or `self-hosted`. " +
+					"The regions `us` and `eu` select the `API` and `IDENTITY` endpoints of the region, which override the environment variables and the profile, and conflict with `api_url` and `identity_url`. " +
+					"The region `self-hosted` requires the endpoints to be provided by `api_url` and `identity_url`, the environment variables or the profile. " +
+					"By default, the endpoints must be provided like for `self-hosted`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("us", "eu", "self-hosted"),
+				},
+			},
 			"access_token": schema.StringAttribute{
 				Description: "Access Token of the used Machine Account for Bitwarden Secrets Manager." +
 					"This configuration value is optional because it can also be provided via BW_ACCESS_TOKEN environment variable. " +
@@ -96,14 +222,225 @@ func (p *BitwardenSecretsManagerProvider) Schema(_ context.Context, _ provider.S
 					stringUUIDValidate(),
 				},
 			},
+			"ignore_missing_on_delete": schema.BoolAttribute{
+				Description: "When set to true, objects which no longer exist in Bitwarden Secrets Manager are removed from the terraform state during deletion instead of failing the destroy. " +
+					"This makes repeated or partial destroys idempotent. The provided default is false.",
+				MarkdownDescription: "When set to `true`, objects which no longer exist in Bitwarden Secrets Manager are removed from the terraform state during deletion instead of failing the destroy. " +
+					"This makes repeated or partial destroys idempotent. The provided default is `false`.",
+				Optional: true,
+			},
+			"verbose_errors": schema.BoolAttribute{
+				Description: "When set to true, the raw error returned by the Bitwarden SDK is appended to the detail of diagnostics for well-known errors, which are otherwise only explained in a user-friendly way. " +
+					"Access tokens and secret values are redacted from the raw error. Unknown errors always contain the raw error. The provided default is true.",
+				MarkdownDescription: "When set to `true`, the raw error returned by the Bitwarden SDK is appended to the detail of diagnostics for well-known errors, which are otherwise only explained in a user-friendly way. " +
+					"Access tokens and secret values are redacted from the raw error. Unknown errors always contain the raw error. The provided default is `true`.",
+				Optional: true,
+			},
+			"warn_value_in_state": schema.BoolAttribute{
+				Description: "When set to true, a warning is shown whenever a configured secret value is about to be stored in the Terraform state, which Terraform does even for sensitive values. " +
+					"The warning recommends generated values tracked by their hash instead. The provided default is false.",
+				MarkdownDescription: "When set to `true`, a warning is shown whenever a configured secret `value` is about to be stored in the Terraform state, which Terraform does even for sensitive values. " +
+					"The warning recommends generated values tracked by their hash instead. The provided default is `false`.",
+				Optional: true,
+			},
+			"validate_project_organization": schema.BoolAttribute{
+				Description: "When set to true, the project of a secret is read before the secret is created or moved, to verify that it belongs to the organization configured on the provider. " +
+					"This replaces the unclear error of the Bitwarden Secrets Manager API with a clear diagnostic at the cost of an additional request. The provided default is false.",
+				MarkdownDescription: "When set to `true`, the project of a secret is read before the secret is created or moved, to verify that it belongs to the `organization_id` configured on the provider. " +
+					"This replaces the unclear error of the Bitwarden Secrets Manager API with a clear diagnostic at the cost of an additional request. The provided default is `false`.",
+				Optional: true,
+			},
+			"metadata_only": schema.BoolAttribute{
+				Description: "When set to true, secret resources whose value the machine account is not permitted to read are refreshed and imported from their metadata instead of failing. " +
+					"Only the key, project and organization of such secrets are read, while their value and note are kept as they are stored in the Terraform state, which is empty after an import. " +
+					"A warning is shown for every such secret. Intended for least-privilege machine accounts. The provided default is false.",
+				MarkdownDescription: "When set to `true`, `secret` resources whose `value` the machine account is not permitted to read are refreshed and imported from their metadata instead of failing. " +
+					"Only the `key`, `project_id` and `organization_id` of such secrets are read, while their `value` and `note` are kept as they are stored in the Terraform state, which is empty after an import. " +
+					"A warning is shown for every such secret. Intended for least-privilege machine accounts. The provided default is `false`.",
+				Optional: true,
+			},
+			"enable_project_cache": schema.BoolAttribute{
+				Description: "When set to true, the projects of the organization are listed once per plan or apply and shared between all resources which read projects, e.g. to verify or resolve the project of a secret. " +
+					"Projects which are not listed are read individually. This saves one request per secret, but changes of projects during a run may be missed. The provided default is false.",
+				MarkdownDescription: "When set to `true`, the projects of the organization are listed once per plan or apply and shared between all resources which read projects, e.g. to verify or resolve the project of a secret. " +
+					"Projects which are not listed are read individually. This saves one request per secret, but changes of projects during a run may be missed. The provided default is `false`.",
+				Optional: true,
+			},
+			"managed_marker": schema.StringAttribute{
+				Description: "A marker, e.g. managed-by-terraform, which is added as the first line of the note of every secret created or updated by the provider, so that external audits can identify secrets managed by Terraform. " +
+					"The marker is removed from the notes read by the provider, so it does not cause differences. Secrets are only marked when they are created or updated.",
+				MarkdownDescription: "A marker, e.g. `managed-by-terraform`, which is added as the first line of the `note` of every secret created or updated by the provider, so that external audits can identify secrets managed by Terraform. " +
+					"The marker is removed from the notes read by the provider, so it does not cause differences. Secrets are only marked when they are created or updated.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[^\r\n]+$`), "must be a single non-empty line"),
+				},
+			},
+			"min_server_version": schema.StringAttribute{
+				Description: "The minimum version of the Bitwarden server, e.g. 2024.12.0, which is verified during the configuration of the provider. " +
+					"The version is read from the config endpoint of the API. If it cannot be determined, the check is skipped with a warning.",
+				MarkdownDescription: "The minimum version of the Bitwarden server, e.g. `2024.12.0`, which is verified during the configuration of the provider. " +
+					"The version is read from the config endpoint of the API. If it cannot be determined, the check is skipped with a warning.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^\d+(\.\d+)*$`), "must be a dotted numeric version, e.g. 2024.12.0"),
+				},
+			},
+			"configure_retries": schema.Int64Attribute{
+				Description: "The number of times the authentication of the client is retried with an exponential backoff if it fails with a transient error, e.g. a network error or an unavailable server. " +
+					"Authentication failures are never retried. The value must be between 0 and 10. The provided default is 0.",
+				MarkdownDescription: "The number of times the authentication of the client is retried with an exponential backoff if it fails with a transient error, e.g. a network error or an unavailable server. " +
+					"Authentication failures are never retried. The value must be between `0` and `10`. The provided default is `0`.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 10),
+				},
+			},
+			"retry_budget_seconds": schema.Int64Attribute{
+				Description: "The total number of seconds a plan or apply may spend retrying transient errors, including the delays between the attempts. " +
+					"Once the budget is exhausted, transient errors fail immediately instead of being retried. Currently only the authentication is retried, see configure_retries. " +
+					"The provided default is no budget, which only limits the number of retries.",
+				MarkdownDescription: "The total number of seconds a plan or apply may spend retrying transient errors, including the delays between the attempts. " +
+					"Once the budget is exhausted, transient errors fail immediately instead of being retried. Currently only the authentication is retried, see `configure_retries`. " +
+					"The provided default is no budget, which only limits the number of retries.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"refresh_ttl_seconds": schema.Int64Attribute{
+				Description: "The number of seconds during which a secret resource is not read again from Bitwarden Secrets Manager after it was last read, created or updated. " +
+					"Refreshes within this window keep the secret from the Terraform state, so changes made outside of Terraform are only detected once the window has passed. " +
+					"Terraform does not tell providers whether a refresh was explicitly requested, so set the environment variable BW_FORCE_REFRESH to true to read all secrets regardless of this window. " +
+					"The provided default is 0, which reads secrets on every refresh.",
+				MarkdownDescription: "The number of seconds during which a `secret` **resource** is not read again from Bitwarden Secrets Manager after it was last read, created or updated. " +
+					"Refreshes within this window keep the secret from the Terraform state, so changes made outside of Terraform are only detected once the window has passed. " +
+					"Terraform does not tell providers whether a refresh was explicitly requested, so set the environment variable `BW_FORCE_REFRESH` to `true` to read all secrets regardless of this window. " +
+					"The provided default is `0`, which reads secrets on every refresh.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"redact_keys": schema.BoolAttribute{
+				Description: "When set to true, secret keys are replaced by a stable hash in logs and diagnostics of the provider, and are redacted from raw errors of the Bitwarden SDK. " +
+					"Secret keys in the terraform state are not affected. Invalid secret keys in the configuration are always referenced by their hash. The provided default is false.",
+				MarkdownDescription: "When set to `true`, secret keys are replaced by a stable hash in logs and diagnostics of the provider, and are redacted from raw errors of the Bitwarden SDK. " +
+					"Secret keys in the terraform state are not affected. Invalid secret keys in the configuration are always referenced by their hash. The provided default is `false`.",
+				Optional: true,
+			},
+			"truncate_timestamps": schema.BoolAttribute{
+				Description: "When set to true, the creation_date and revision_date of secrets and projects are truncated to whole seconds in all resources and data sources, " +
+					"so that sub-second differences returned by the API do not cause differences in plans. The provided default is false, which keeps the full precision.",
+				MarkdownDescription: "When set to `true`, the `creation_date` and `revision_date` of secrets and projects are truncated to whole seconds in all resources and data sources, " +
+					"so that sub-second differences returned by the API do not cause differences in plans. The provided default is `false`, which keeps the full precision.",
+				Optional: true,
+			},
+			"log_summary": schema.BoolAttribute{
+				Description: "When set to true, the number of secrets created, updated and deleted by the provider as well as the number and the total duration of the calls to the Bitwarden SDK are logged at the INFO level. " +
+					"Terraform does not notify providers at the end of a run, so the cumulative summary is logged after every create, update and delete, and the last summary of a run covers the whole run. The provided default is false.",
+				MarkdownDescription: "When set to `true`, the number of secrets created, updated and deleted by the provider as well as the number and the total duration of the calls to the Bitwarden SDK are logged at the `INFO` level. " +
+					"Terraform does not notify providers at the end of a run, so the cumulative summary is logged after every create, update and delete, and the last summary of a run covers the whole run. The provided default is `false`.",
+				Optional: true,
+			},
+			"log_timings": schema.BoolAttribute{
+				Description: "When set to true, the wall-clock duration of every call to the Bitwarden SDK is logged with the name of the operation and the ID of the affected object, or the ID of the organization for creates, listings and syncs. " +
+					"The durations are logged at the INFO level. The provided default is false.",
+				MarkdownDescription: "When set to `true`, the wall-clock duration of every call to the Bitwarden SDK is logged with the name of the operation and the `ID` of the affected object, or the `ID` of the organization for creates, listings and syncs. " +
+					"The durations are logged at the `INFO` level. The provided default is `false`.",
+				Optional: true,
+			},
+			"log_level": schema.StringAttribute{
+				Description: "The minimum level of the log entries written by the provider itself, independently of the level of the Terraform core logs. " +
+					"Must be one of trace, debug, info or warn. Terraform only shows provider logs up to the level configured with TF_LOG or TF_LOG_PROVIDER, " +
+					"so TF_LOG_PROVIDER=TRACE combined with log_level shows detailed provider logs only. By default, the level configured by Terraform is used.",
+				MarkdownDescription: "The minimum level of the log entries written by the provider itself, independently of the level of the Terraform core logs. " +
+					"Must be one of `trace`, `debug`, `info` or `warn`. Terraform only shows provider logs up to the level configured with `TF_LOG` or `TF_LOG_PROVIDER`, " +
+					"so `TF_LOG_PROVIDER=TRACE` combined with `log_level` shows detailed provider logs only. By default, the level configured by Terraform is used.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("trace", "debug", "info", "warn"),
+				},
+			},
+			"profile": schema.StringAttribute{
+				Description: "Name of a profile in the profile file whose api_url, identity_url, access_token and organization_id are used. " +
+					"Settings of the profile override the environment variables, and explicitly configured attributes override the profile.",
+				MarkdownDescription: "Name of a profile in the profile file whose `api_url`, `identity_url`, `access_token` and `organization_id` are used. " +
+					"Settings of the profile override the environment variables, and explicitly configured attributes override the profile.",
+				Optional: true,
+			},
+			"profile_file": schema.StringAttribute{
+				Description: "Path of the TOML profile file in which every profile is a [profiles.<name>] table. " +
+					"Requires profile to be set. The provided default is ~/.bws/config.",
+				MarkdownDescription: "Path of the `TOML` profile file in which every profile is a `[profiles.<name>]` table. " +
+					"Requires `profile` to be set. The provided default is `~/.bws/config`.",
+				Optional: true,
+			},
 		},
 	}
 }
 
+// ValidateConfig reports invalid combinations of configured attributes before the provider is configured. Values which
+// are missing in the configuration may be provided by environment variables or a profile and are only reported during
+// Configure.
+func (p *BitwardenSecretsManagerProvider) ValidateConfig(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+	var config BitwardenSecretsManagerProviderModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.ProfileFile.IsNull() && config.Profile.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("profile_file"),
+			"Profile File Without Profile",
+			"profile_file is only used to read the profile configured with profile, which is not set. "+
+				"Set profile to the name of a profile in the profile file, or remove profile_file from the configuration.",
+		)
+	}
+
+	_, cloudRegion := regionEndpoints[config.Region.ValueString()]
+	if cloudRegion {
+		for attribute, endpoint := range map[string]types.String{"api_url": config.ApiUrl, "identity_url": config.IdentityUrl} {
+			if !endpoint.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(attribute),
+					"Conflicting Endpoint Configuration",
+					fmt.Sprintf("%s cannot be configured together with the region %q, which selects the endpoints of the Bitwarden cloud. "+
+						"Remove %s from the configuration, or set region to self-hosted to use a custom endpoint.", attribute, config.Region.ValueString(), attribute),
+				)
+			}
+		}
+	}
+
+	endpointsConfigured := cloudRegion || (!config.ApiUrl.IsNull() && !config.IdentityUrl.IsNull())
+	if !config.Profile.IsNull() && endpointsConfigured && !config.AccessToken.IsNull() && !config.OrganizationId.IsNull() {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("profile"),
+			"Profile Is Not Used",
+			"The endpoints, access_token and organization_id are all configured explicitly and override every setting of the profile. "+
+				"Remove profile from the configuration or remove the attributes which should be read from the profile.",
+		)
+	}
+
+	validateEndpointUrl(path.Root("api_url"), "API", config.ApiUrl, &resp.Diagnostics)
+	validateEndpointUrl(path.Root("identity_url"), "IDENTITY", config.IdentityUrl, &resp.Diagnostics)
+}
+
+// validateEndpointUrl reports a configured endpoint URI which cannot be used. Warnings about plain http are only
+// reported once during Configure.
+func validateEndpointUrl(attribute path.Path, endpoint string, rawUrl types.String, diags *diag.Diagnostics) {
+	if rawUrl.IsNull() || rawUrl.IsUnknown() {
+		return
+	}
+	var endpointDiags diag.Diagnostics
+	normalizeEndpointUrl(attribute, endpoint, rawUrl.ValueString(), &endpointDiags)
+	diags.Append(endpointDiags.Errors()...)
+}
+
 func (p *BitwardenSecretsManagerProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	// Retrieve provider data from configuration
-	tflog.Info(ctx, "Configuring Bitwarden Secrets Manager")
-
 	var config BitwardenSecretsManagerProviderModel
 	diags := req.Config.Get(ctx, &config)
 	resp.Diagnostics.Append(diags...)
@@ -111,6 +448,9 @@ func (p *BitwardenSecretsManagerProvider) Configure(ctx context.Context, req pro
 		return
 	}
 
+	ctx = newLogContext(ctx, config.LogLevel.ValueString())
+	tflog.SubsystemInfo(ctx, logSubsystem, "Configuring Bitwarden Secrets Manager")
+
 	// If practitioner provided a configuration value for any of the
 	// attributes, it must be a known value.
 
@@ -132,6 +472,15 @@ func (p *BitwardenSecretsManagerProvider) Configure(ctx context.Context, req pro
 		)
 	}
 
+	if config.Region.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("region"),
+			"Unknown Bitwarden Secrets Manager Region",
+			"The provider cannot create the Bitwarden Secrets Manager API bitwardenClient as there is an unknown configuration value for the region. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
 	if config.AccessToken.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("access_token"),
@@ -150,6 +499,15 @@ func (p *BitwardenSecretsManagerProvider) Configure(ctx context.Context, req pro
 		)
 	}
 
+	if config.Profile.IsUnknown() || config.ProfileFile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("profile"),
+			"Unknown Bitwarden Secrets Manager Profile",
+			"The provider cannot create the Bitwarden Secrets Manager API bitwardenClient as there is an unknown configuration value for the profile or the profile file. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -162,6 +520,28 @@ func (p *BitwardenSecretsManagerProvider) Configure(ctx context.Context, req pro
 	accessToken := os.Getenv("BW_ACCESS_TOKEN")
 	organizationId := os.Getenv("BW_ORGANIZATION_ID")
 
+	if !config.Profile.IsNull() {
+		profile, err := readProfile(config.ProfileFile.ValueString(), config.Profile.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("profile"),
+				"Unable to Read Bitwarden Secrets Manager Profile",
+				"The provider cannot read the configured profile: "+err.Error(),
+			)
+			return
+		}
+
+		apiUrl = overrideWithProfile(apiUrl, profile.ApiUrl)
+		identityUrl = overrideWithProfile(identityUrl, profile.IdentityUrl)
+		accessToken = overrideWithProfile(accessToken, profile.AccessToken)
+		organizationId = overrideWithProfile(organizationId, profile.OrganizationId)
+	}
+
+	if endpoints, ok := regionEndpoints[config.Region.ValueString()]; ok {
+		apiUrl = endpoints.apiUrl
+		identityUrl = endpoints.identityUrl
+	}
+
 	if !config.ApiUrl.IsNull() {
 		apiUrl = config.ApiUrl.ValueString()
 	}
@@ -186,7 +566,7 @@ func (p *BitwardenSecretsManagerProvider) Configure(ctx context.Context, req pro
 			path.Root("api_url"),
 			"Missing URI for Bitwarden Secrets Manager API endpoint",
 			"The provider cannot create the Bitwarden Secrets Manager API bitwardenClient as there is a missing or empty configuration value for the URI of the Bitwarden Secrets Manager API endpoint. "+
-				"Set the api_url value in the configuration or use the BW_API_URL environment variable. "+
+				"Set the api_url value in the configuration, use the BW_API_URL environment variable, or set region to us or eu for the Bitwarden cloud. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
@@ -196,7 +576,7 @@ func (p *BitwardenSecretsManagerProvider) Configure(ctx context.Context, req pro
 			path.Root("identity_url"),
 			"Missing URI for Bitwarden Secrets Manager IDENTITY endpoint",
 			"The provider cannot create the Bitwarden Secrets Manager API bitwardenClient as there is a missing or empty configuration value for the URI of the Bitwarden Secrets Manager IDENTITY endpoint. "+
-				"Set the identity_url value in the configuration or use the BW_IDENTITY_API_URL environment variable. "+
+				"Set the identity_url value in the configuration, use the BW_IDENTITY_API_URL environment variable, or set region to us or eu for the Bitwarden cloud. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
@@ -219,64 +599,242 @@ func (p *BitwardenSecretsManagerProvider) Configure(ctx context.Context, req pro
 				"Set the organization_id value in the configuration or use the BW_ORGANIZATION_ID environment variable. "+
 				"If either is already set, ensure the value is not empty.",
 		)
+	} else if err := uuid.Validate(organizationId); err != nil {
+		// The configuration value is validated by the schema, but the environment variable and the profile are not.
+		resp.Diagnostics.AddAttributeError(
+			path.Root("organization_id"),
+			"Invalid Bitwarden Secrets Manager Organization ID",
+			"The provider cannot create the Bitwarden Secrets Manager API bitwardenClient as the configured Organization ID of Bitwarden Secrets Manager endpoint is not a valid UUID. "+
+				"Verify the organization_id value in the configuration, the profile or the BW_ORGANIZATION_ID environment variable.",
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
+	apiUrl = normalizeEndpointUrl(path.Root("api_url"), "API", apiUrl, &resp.Diagnostics)
+	identityUrl = normalizeEndpointUrl(path.Root("identity_url"), "IDENTITY", identityUrl, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	ctx = tflog.SetField(ctx, "bitwarden_secrets_manager_api_url", apiUrl)
-	ctx = tflog.SetField(ctx, "bitwarden_secrets_manager_identity_url", identityUrl)
-	ctx = tflog.SetField(ctx, "bitwarden_secrets_manager_access_token", accessToken)
-	ctx = tflog.SetField(ctx, "bitwarden_secrets_manager_organization_id", organizationId)
-	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "bitwarden_secrets_manager_access_token")
-	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "bitwarden_secrets_manager_organization_id")
+	tflog.SubsystemDebug(ctx, logSubsystem, "Normalized Bitwarden Secrets Manager endpoints", map[string]any{
+		"api_url":      apiUrl,
+		"identity_url": identityUrl,
+	})
+
+	verboseErrors := config.VerboseErrors.IsNull() || config.VerboseErrors.ValueBool()
+
+	ctx = tflog.SubsystemSetField(ctx, logSubsystem, "bitwarden_secrets_manager_api_url", apiUrl)
+	ctx = tflog.SubsystemSetField(ctx, logSubsystem, "bitwarden_secrets_manager_identity_url", identityUrl)
+	ctx = tflog.SubsystemSetField(ctx, logSubsystem, "bitwarden_secrets_manager_access_token", accessToken)
+	ctx = tflog.SubsystemSetField(ctx, logSubsystem, "bitwarden_secrets_manager_organization_id", organizationId)
+	ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, logSubsystem, "bitwarden_secrets_manager_access_token")
+	ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, logSubsystem, "bitwarden_secrets_manager_organization_id")
+
+	if config.MinServerVersion.ValueString() != "" {
+		checkServerVersion(ctx, apiUrl, config.MinServerVersion.ValueString(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
-	tflog.Debug(ctx, "Creating Bitwarden Secrets Manager Client")
+	tflog.SubsystemDebug(ctx, logSubsystem, "Creating Bitwarden Secrets Manager Client")
 
 	// Create a new bitwardenClient using the configuration values
-	bitwardenClient, err := sdk.NewBitwardenClient(&apiUrl, &identityUrl)
+	bitwardenClient, err := createBitwardenClient(&apiUrl, &identityUrl)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create Bitwarden Secrets Manager Client",
 			"An unexpected error occurred when creating the Bitwarden Secrets Manager Client. "+
 				"If the error is not clear, please contact the provider developers.\n\n"+
-				"Bitwarden Secrets Manager Client Error: "+err.Error(),
+				sdkErrorDetail(err, organizationId, verboseErrors),
 		)
 		return
 	}
 
-	tflog.Debug(ctx, "Bitwarden Secrets Manager Client created")
+	tflog.SubsystemDebug(ctx, logSubsystem, "Bitwarden Secrets Manager Client created")
 
-	err = bitwardenClient.AccessTokenLogin(accessToken, &statePath)
+	var summary *operationSummary
+	if config.LogSummary.ValueBool() {
+		summary = &operationSummary{}
+	}
+	if config.LogTimings.ValueBool() || summary != nil {
+		bitwardenClient = newTimingBitwardenClient(ctx, bitwardenClient, config.LogTimings.ValueBool(), summary)
+	}
+	if config.ManagedMarker.ValueString() != "" {
+		bitwardenClient = newMarkerBitwardenClient(bitwardenClient, config.ManagedMarker.ValueString())
+	}
+	if config.TruncateTimestamps.ValueBool() {
+		bitwardenClient = newTruncatingBitwardenClient(bitwardenClient)
+	}
+
+	var budget *retryBudget
+	if !config.RetryBudgetSeconds.IsNull() {
+		budget = newRetryBudget(time.Duration(config.RetryBudgetSeconds.ValueInt64()) * time.Second)
+	}
+
+	err = loginWithRetries(ctx, bitwardenClient, accessToken, config.ConfigureRetries.ValueInt64(), budget)
+	var budgetErr *retryBudgetExhaustedError
+	if errors.As(err, &budgetErr) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_budget_seconds"),
+			"Retry Budget Exhausted",
+			fmt.Sprintf("The authentication of the Bitwarden Secrets Manager Client failed with a transient error, which was not retried because the retry budget of %s configured in retry_budget_seconds is exhausted. "+
+				"Increase the budget or retry the operation later.\n\n%s", budgetErr.budget, sdkErrorDetail(budgetErr.err, organizationId, verboseErrors, accessToken)),
+		)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Authenticate Bitwarden Secrets Manager Client",
 			"An unexpected error occurred when authenticating the Bitwarden Secrets Manager Client against the configured endpoint. "+
 				"If the error is not clear, please contact the provider developers.\n\n"+
-				"Bitwarden Secrets Manager Client Error: "+err.Error(),
+				sdkErrorDetail(err, organizationId, verboseErrors, accessToken),
 		)
 		return
 	}
 
-	tflog.Debug(ctx, "Bitwarden Secrets Manager Client authenticated")
+	tflog.SubsystemDebug(ctx, logSubsystem, "Bitwarden Secrets Manager Client authenticated")
+	removeLegacyStateFile(ctx)
 
 	// Make the bitwardenClient available during DataSource and Resource
 	// type Configure methods.
 	providerDataStruct := BitwardenSecretsManagerProviderDataStruct{
-		bitwardenClient,
-		organizationId,
+		bitwardenClient:             bitwardenClient,
+		organizationId:              organizationId,
+		ignoreMissingOnDelete:       config.IgnoreMissingOnDelete.ValueBool(),
+		verboseErrors:               verboseErrors,
+		redactKeys:                  config.RedactKeys.ValueBool(),
+		logLevel:                    config.LogLevel.ValueString(),
+		refreshTtl:                  refreshTtl(config.RefreshTtlSeconds),
+		summary:                     summary,
+		warnValueInState:            config.WarnValueInState.ValueBool(),
+		validateProjectOrganization: config.ValidateProjectOrganization.ValueBool(),
+		metadataOnly:                config.MetadataOnly.ValueBool(),
+	}
+	if config.EnableProjectCache.ValueBool() {
+		providerDataStruct.projectCache = newProjectCache()
 	}
 
 	resp.DataSourceData = providerDataStruct
 	resp.ResourceData = providerDataStruct
 
-	tflog.Info(ctx, "Configured Bitwarden Secrets Manager Client", map[string]any{"success": true})
+	tflog.SubsystemInfo(ctx, logSubsystem, "Configured Bitwarden Secrets Manager Client", map[string]any{"success": true})
+}
+
+// refreshTtl returns the duration during which secrets are not read again, unless BW_FORCE_REFRESH is set to true.
+func refreshTtl(refreshTtlSeconds types.Int64) time.Duration {
+	if forceRefresh, _ := strconv.ParseBool(os.Getenv("BW_FORCE_REFRESH")); forceRefresh {
+		return 0
+	}
+	return time.Duration(refreshTtlSeconds.ValueInt64()) * time.Second
+}
+
+// overrideWithProfile returns the setting of a profile, unless it is not set in the profile.
+func overrideWithProfile(value string, profileValue string) string {
+	if profileValue == "" {
+		return value
+	}
+	return profileValue
+}
+
+// normalizeEndpointUrl trims trailing slashes from a configured endpoint URI, which would otherwise lead to double
+// slashes in the requested paths, and defaults its scheme to https. URIs which cannot be parsed or use a scheme other
+// than https are reported, plain http with a warning only.
+func normalizeEndpointUrl(attribute path.Path, endpoint string, rawUrl string, diags *diag.Diagnostics) string {
+	rawUrl = strings.TrimSpace(rawUrl)
+	if !strings.Contains(rawUrl, "://") {
+		rawUrl = "https://" + rawUrl
+	}
+
+	parsedUrl, err := url.Parse(rawUrl)
+	if err != nil || parsedUrl.Host == "" || (parsedUrl.Scheme != "https" && parsedUrl.Scheme != "http") {
+		diags.AddAttributeError(
+			attribute,
+			"Invalid URI for Bitwarden Secrets Manager "+endpoint+" endpoint",
+			fmt.Sprintf("The configured URI %q of the Bitwarden Secrets Manager %s endpoint is not a valid http or https URI.", rawUrl, endpoint),
+		)
+		return rawUrl
+	}
+
+	if parsedUrl.Scheme == "http" {
+		diags.AddAttributeWarning(
+			attribute,
+			"Insecure URI for Bitwarden Secrets Manager "+endpoint+" endpoint",
+			fmt.Sprintf("The configured URI %q of the Bitwarden Secrets Manager %s endpoint uses plain http. "+
+				"Access tokens and secrets are sent unencrypted. Use https unless the endpoint is only reachable locally.", rawUrl, endpoint),
+		)
+	}
+
+	return strings.TrimRight(parsedUrl.String(), "/")
+}
+
+// stateFilePath returns the path of the file in which the Bitwarden SDK caches the session of an access token.
+// Every access token uses its own file, so that provider aliases with different access tokens do not overwrite each other's session.
+func stateFilePath(accessToken string) string {
+	return ".bw-provider-state-" + hashSecretValue(accessToken)[:16]
+}
+
+// legacyStateFilePath is the path of the file in which earlier versions of the provider cached the session of every
+// access token.
+const legacyStateFilePath = ".bw-provider-state"
+
+// removeLegacyStateFile removes the session file of earlier versions of the provider, which is no longer read and would
+// otherwise keep a cached session in the working directory. Failures are only logged, because the file is not needed.
+func removeLegacyStateFile(ctx context.Context) {
+	err := os.Remove(legacyStateFilePath)
+	if err == nil {
+		tflog.SubsystemInfo(ctx, logSubsystem, "Removed legacy session file", map[string]any{"path": legacyStateFilePath})
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		tflog.SubsystemWarn(ctx, logSubsystem, "Unable to remove legacy session file", map[string]any{"path": legacyStateFilePath, "error": err.Error()})
+	}
+}
+
+// loginWithRetries authenticates the client and retries the authentication up to the given number of times
+// if it fails with a transient error, as long as the retry budget suffices.
+func loginWithRetries(ctx context.Context, bitwardenClient sdk.BitwardenClientInterface, accessToken string, retries int64, budget *retryBudget) error {
+	statePath := stateFilePath(accessToken)
+	for attempt := int64(1); ; attempt++ {
+		tflog.SubsystemDebug(ctx, logSubsystem, "Authenticating Bitwarden Secrets Manager Client", map[string]any{"attempt": attempt})
+
+		start := time.Now()
+		err := bitwardenClient.AccessTokenLogin(accessToken, &statePath)
+		if attempt > 1 {
+			budget.charge(time.Since(start))
+		}
+		if err == nil || attempt > retries || !isTransientError(err.Error()) {
+			return err
+		}
+
+		delay := configureRetryBackoff(attempt)
+		if !budget.reserve(delay) {
+			tflog.SubsystemWarn(ctx, logSubsystem, "Retry budget exhausted, not retrying the authentication", map[string]any{
+				"attempt": attempt,
+				"budget":  budget.total.String(),
+			})
+			return &retryBudgetExhaustedError{budget: budget.total, err: err}
+		}
+		tflog.SubsystemWarn(ctx, logSubsystem, "Authentication failed with a transient error, retrying", map[string]any{
+			"attempt": attempt,
+			"delay":   delay.String(),
+			"error":   redactSdkError(err.Error(), accessToken),
+		})
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay):
+		}
+	}
 }
 
 func (p *BitwardenSecretsManagerProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewSecretResource,
+		NewProjectSecretsResource,
+		NewSecretsFileResource,
 	}
 }
 
@@ -285,6 +843,14 @@ func (p *BitwardenSecretsManagerProvider) DataSources(_ context.Context) []func(
 		NewProjectsDataSource,
 		NewListSecretsDataSource,
 		NewSecretDataSource,
+		NewSecretsDiffDataSource,
+		NewDotenvDataSource,
+		NewSecretsJsonDataSource,
+		NewSecretsYamlDataSource,
+		NewProjectSecretsDataSource,
+		NewSecretsByIdDataSource,
+		NewSecretPatternCheckDataSource,
+		NewProjectAccessCheckDataSource,
 	}
 }
 
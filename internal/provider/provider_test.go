@@ -1,10 +1,20 @@
 package provider
 
 import (
+	"context"
+	"errors"
+	"github.com/bitwarden/sdk-go/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"io/fs"
 	"os"
+	"path/filepath"
 	"regexp"
 	"testing"
+	"time"
 )
 
 const (
@@ -309,3 +319,443 @@ func TestAccProviderConfigurationValid(t *testing.T) {
 		},
 	})
 }
+
+func TestConfigureClient(t *testing.T) {
+	tests := map[string]struct {
+		providerData any
+		expectOk     bool
+		expectError  bool
+	}{
+		"not configured yet": {providerData: nil},
+		"unexpected type":    {providerData: "client", expectError: true},
+		"missing client":     {providerData: BitwardenSecretsManagerProviderDataStruct{organizationId: mockOrgId}, expectError: true},
+		"missing organization": {
+			providerData: BitwardenSecretsManagerProviderDataStruct{bitwardenClient: newMockBitwardenClient()},
+			expectError:  true,
+		},
+		"valid": {
+			providerData: BitwardenSecretsManagerProviderDataStruct{bitwardenClient: newMockBitwardenClient(), organizationId: mockOrgId},
+			expectOk:     true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			_, ok := configureClient(context.Background(), test.providerData, &diags)
+			if ok != test.expectOk {
+				t.Errorf("expected ok to be %t, got: %t", test.expectOk, ok)
+			}
+			if diags.HasError() != test.expectError {
+				t.Errorf("expected error to be %t, got: %v", test.expectError, diags)
+			}
+		})
+	}
+}
+
+func TestLoginWithRetries(t *testing.T) {
+	originalBackoff := configureRetryBackoff
+	configureRetryBackoff = func(int64) time.Duration { return 0 }
+	t.Cleanup(func() { configureRetryBackoff = originalBackoff })
+
+	tests := map[string]struct {
+		errors           []string
+		retries          int64
+		expectedAttempts int
+		expectError      bool
+	}{
+		"success":                       {retries: 3, expectedAttempts: 1},
+		"transient error recovers":      {errors: []string{"connection refused", "API error: [503 Service Unavailable]"}, retries: 3, expectedAttempts: 3},
+		"transient error exceeds limit": {errors: []string{"connection refused", "connection refused"}, retries: 1, expectedAttempts: 2, expectError: true},
+		"no retries configured":         {errors: []string{"connection refused"}, expectedAttempts: 1, expectError: true},
+		"authentication failure":        {errors: []string{"API error: [400 Bad Request] invalid_client"}, retries: 3, expectedAttempts: 1, expectError: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := newMockBitwardenClient()
+			attempt := 0
+			client.loginHook = func(string) error {
+				defer func() { attempt++ }()
+				if attempt < len(test.errors) {
+					return errors.New(test.errors[attempt])
+				}
+				return nil
+			}
+
+			err := loginWithRetries(context.Background(), client, "token", test.retries, nil)
+			if (err != nil) != test.expectError {
+				t.Errorf("expected error to be %t, got: %v", test.expectError, err)
+			}
+			if attempts := client.callCount("AccessTokenLogin"); attempts != test.expectedAttempts {
+				t.Errorf("expected %d attempts, got: %d", test.expectedAttempts, attempts)
+			}
+		})
+	}
+}
+
+func TestRemoveLegacyStateFile(t *testing.T) {
+	t.Chdir(t.TempDir())
+	if err := os.WriteFile(legacyStateFilePath, []byte("session"), 0o600); err != nil {
+		t.Fatalf("unable to write the legacy session file: %v", err)
+	}
+	if err := os.WriteFile(stateFilePath("token"), []byte("session"), 0o600); err != nil {
+		t.Fatalf("unable to write the session file: %v", err)
+	}
+
+	removeLegacyStateFile(context.Background())
+	if _, err := os.Stat(legacyStateFilePath); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("expected the legacy session file to be removed, got: %v", err)
+	}
+	if _, err := os.Stat(stateFilePath("token")); err != nil {
+		t.Errorf("expected the session file of the access token to be kept, got: %v", err)
+	}
+
+	// A missing legacy session file is not an error.
+	removeLegacyStateFile(context.Background())
+}
+
+func TestLoginWithRetriesBudget(t *testing.T) {
+	originalBackoff := configureRetryBackoff
+	configureRetryBackoff = func(int64) time.Duration { return 10 * time.Millisecond }
+	t.Cleanup(func() { configureRetryBackoff = originalBackoff })
+
+	client := newMockBitwardenClient()
+	client.loginHook = func(string) error {
+		return errors.New("API error: [429 Too Many Requests]")
+	}
+
+	// The budget suffices for two delays, so the third attempt fails immediately although more retries are allowed.
+	err := loginWithRetries(context.Background(), client, "token", 10, newRetryBudget(25*time.Millisecond))
+	var budgetErr *retryBudgetExhaustedError
+	if !errors.As(err, &budgetErr) || budgetErr.budget != 25*time.Millisecond {
+		t.Fatalf("expected the retry budget to be exhausted, got: %v", err)
+	}
+	if attempts := client.callCount("AccessTokenLogin"); attempts != 3 {
+		t.Errorf("expected 3 attempts, got: %d", attempts)
+	}
+
+	// An exhausted budget fails the first transient error immediately.
+	client = newMockBitwardenClient()
+	client.loginHook = func(string) error {
+		return errors.New("connection refused")
+	}
+	err = loginWithRetries(context.Background(), client, "token", 10, newRetryBudget(0))
+	if !errors.As(err, &budgetErr) || client.callCount("AccessTokenLogin") != 1 {
+		t.Fatalf("expected the first transient error to fail immediately, got: %v", err)
+	}
+}
+
+func TestProviderConfigureInvalidOrganizationIdFromEnvironment(t *testing.T) {
+	t.Setenv("BW_API_URL", "https://api.bitwarden.com")
+	t.Setenv("BW_IDENTITY_API_URL", "https://identity.bitwarden.com")
+	t.Setenv("BW_ACCESS_TOKEN", "token")
+	t.Setenv("BW_ORGANIZATION_ID", "my-organization")
+
+	p := &BitwardenSecretsManagerProvider{}
+	resp := provider.ConfigureResponse{}
+	p.Configure(context.Background(), provider.ConfigureRequest{Config: newTestProviderConfig(t, p, BitwardenSecretsManagerProviderModel{})}, &resp)
+
+	if !diagnosticsContain(resp.Diagnostics, "Invalid Bitwarden Secrets Manager Organization ID") {
+		t.Fatalf("expected diagnostic about invalid organization ID, got: %v", resp.Diagnostics)
+	}
+}
+
+func TestProviderAliasesAreIsolated(t *testing.T) {
+	originalFactory := createBitwardenClient
+	createBitwardenClient = func(_ *string, _ *string) (sdk.BitwardenClientInterface, error) {
+		return newMockBitwardenClient(), nil
+	}
+	t.Cleanup(func() { createBitwardenClient = originalFactory })
+
+	aliases := map[string]BitwardenSecretsManagerProviderModel{
+		"first": {
+			ApiUrl:         types.StringValue("https://api.bitwarden.com"),
+			IdentityUrl:    types.StringValue("https://identity.bitwarden.com"),
+			AccessToken:    types.StringValue("first-token"),
+			OrganizationId: types.StringValue(mockOrgId),
+		},
+		"second": {
+			ApiUrl:         types.StringValue("https://api.bitwarden.eu"),
+			IdentityUrl:    types.StringValue("https://identity.bitwarden.eu"),
+			AccessToken:    types.StringValue("second-token"),
+			OrganizationId: types.StringValue(validProjectUUID),
+		},
+	}
+
+	providerData := map[string]BitwardenSecretsManagerProviderDataStruct{}
+	for alias, config := range aliases {
+		p := New("test")()
+		resp := provider.ConfigureResponse{}
+		p.Configure(context.Background(), provider.ConfigureRequest{Config: newTestProviderConfig(t, p, config)}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error configuring alias %s: %v", alias, resp.Diagnostics)
+		}
+		providerData[alias] = resp.ResourceData.(BitwardenSecretsManagerProviderDataStruct)
+	}
+
+	first, second := providerData["first"], providerData["second"]
+	firstClient := first.bitwardenClient.(*mockBitwardenClient)
+	secondClient := second.bitwardenClient.(*mockBitwardenClient)
+	if firstClient == secondClient {
+		t.Fatal("expected every alias to use its own client")
+	}
+	if first.organizationId != mockOrgId || second.organizationId != validProjectUUID {
+		t.Errorf("expected every alias to use its own organization, got: %s and %s", first.organizationId, second.organizationId)
+	}
+	if firstClient.loginAccessToken != "first-token" || secondClient.loginAccessToken != "second-token" {
+		t.Errorf("expected every alias to login with its own access token, got: %s and %s", firstClient.loginAccessToken, secondClient.loginAccessToken)
+	}
+	if firstClient.loginStatePath == secondClient.loginStatePath {
+		t.Errorf("expected every alias to use its own state file, got: %s", firstClient.loginStatePath)
+	}
+
+	firstClient.addSecret("key", "value", "", mockOrgId, validProjectUUID)
+	if secrets, _ := secondClient.Secrets().List(mockOrgId); len(secrets.Data) != 0 {
+		t.Errorf("expected secrets of the first alias not to be visible to the second alias, got: %v", secrets.Data)
+	}
+}
+
+func TestNormalizeEndpointUrl(t *testing.T) {
+	tests := map[string]struct {
+		rawUrl        string
+		expected      string
+		expectWarning bool
+		expectError   bool
+	}{
+		"unchanged":         {rawUrl: "https://vault.example.com", expected: "https://vault.example.com"},
+		"trailing slash":    {rawUrl: "https://vault.example.com/", expected: "https://vault.example.com"},
+		"trailing slashes":  {rawUrl: "https://vault.example.com/api//", expected: "https://vault.example.com/api"},
+		"missing scheme":    {rawUrl: "vault.example.com/", expected: "https://vault.example.com"},
+		"plain http":        {rawUrl: "http://localhost:8080/", expected: "http://localhost:8080", expectWarning: true},
+		"unsupported":       {rawUrl: "ftp://vault.example.com", expectError: true},
+		"missing host":      {rawUrl: "https:///api", expectError: true},
+		"surrounding space": {rawUrl: " https://vault.example.com/ ", expected: "https://vault.example.com"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			normalized := normalizeEndpointUrl(path.Root("api_url"), "API", test.rawUrl, &diags)
+
+			if diags.HasError() != test.expectError {
+				t.Fatalf("expected error to be %t, got: %v", test.expectError, diags)
+			}
+			if test.expectError {
+				return
+			}
+			if (diags.WarningsCount() > 0) != test.expectWarning {
+				t.Errorf("expected warning to be %t, got: %v", test.expectWarning, diags)
+			}
+			if normalized != test.expected {
+				t.Errorf("expected %s, got: %s", test.expected, normalized)
+			}
+		})
+	}
+}
+
+func TestProviderConfigureProfile(t *testing.T) {
+	originalFactory := createBitwardenClient
+	var clientApiUrl string
+	createBitwardenClient = func(apiUrl *string, _ *string) (sdk.BitwardenClientInterface, error) {
+		clientApiUrl = *apiUrl
+		return newMockBitwardenClient(), nil
+	}
+	t.Cleanup(func() { createBitwardenClient = originalFactory })
+
+	for _, variable := range []string{"BW_API_URL", "BW_IDENTITY_API_URL", "BW_ACCESS_TOKEN", "BW_ORGANIZATION_ID"} {
+		t.Setenv(variable, "")
+	}
+
+	profileFile := filepath.Join(t.TempDir(), "config")
+	err := os.WriteFile(profileFile, []byte(`
+[profiles.dev]
+api_url = "https://api.dev.example.com"
+identity_url = "https://identity.dev.example.com"
+access_token = "dev-token"
+organization_id = "`+mockOrgId+`"
+
+[profiles.typo]
+acess_token = "dev-token"
+`), 0600)
+	if err != nil {
+		t.Fatalf("unable to write profile file: %v", err)
+	}
+
+	tests := map[string]struct {
+		config         BitwardenSecretsManagerProviderModel
+		expectedApiUrl string
+		expectedOrgId  string
+		expectedError  string
+	}{
+		"profile": {
+			config:         BitwardenSecretsManagerProviderModel{Profile: types.StringValue("dev"), ProfileFile: types.StringValue(profileFile)},
+			expectedApiUrl: "https://api.dev.example.com",
+			expectedOrgId:  mockOrgId,
+		},
+		"explicit attributes override profile": {
+			config: BitwardenSecretsManagerProviderModel{
+				Profile:        types.StringValue("dev"),
+				ProfileFile:    types.StringValue(profileFile),
+				ApiUrl:         types.StringValue("https://api.example.com"),
+				OrganizationId: types.StringValue(validProjectUUID),
+			},
+			expectedApiUrl: "https://api.example.com",
+			expectedOrgId:  validProjectUUID,
+		},
+		"unknown profile": {
+			config:        BitwardenSecretsManagerProviderModel{Profile: types.StringValue("prod"), ProfileFile: types.StringValue(profileFile)},
+			expectedError: `the profile "prod" does not exist`,
+		},
+		"unknown setting": {
+			config:        BitwardenSecretsManagerProviderModel{Profile: types.StringValue("typo"), ProfileFile: types.StringValue(profileFile)},
+			expectedError: "unknown setting",
+		},
+		"missing profile file": {
+			config:        BitwardenSecretsManagerProviderModel{Profile: types.StringValue("dev"), ProfileFile: types.StringValue(profileFile + ".missing")},
+			expectedError: "does not exist",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := New("test")()
+			resp := provider.ConfigureResponse{}
+			p.Configure(context.Background(), provider.ConfigureRequest{Config: newTestProviderConfig(t, p, test.config)}, &resp)
+
+			if test.expectedError != "" {
+				if !diagnosticsContain(resp.Diagnostics, test.expectedError) {
+					t.Fatalf("expected error containing %q, got: %v", test.expectedError, resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			providerData := resp.ResourceData.(BitwardenSecretsManagerProviderDataStruct)
+			if clientApiUrl != test.expectedApiUrl || providerData.organizationId != test.expectedOrgId {
+				t.Errorf("expected api_url %s and organization_id %s, got: %s and %s", test.expectedApiUrl, test.expectedOrgId, clientApiUrl, providerData.organizationId)
+			}
+			if login := providerData.bitwardenClient.(*mockBitwardenClient).loginAccessToken; login != "dev-token" {
+				t.Errorf("expected the access token of the profile, got: %s", login)
+			}
+		})
+	}
+}
+
+func TestProviderValidateConfig(t *testing.T) {
+	tests := map[string]struct {
+		config          BitwardenSecretsManagerProviderModel
+		expectedError   string
+		expectedWarning string
+	}{
+		"valid": {
+			config: BitwardenSecretsManagerProviderModel{ApiUrl: types.StringValue("https://api.example.com"), Profile: types.StringValue("dev")},
+		},
+		"profile file without profile": {
+			config:        BitwardenSecretsManagerProviderModel{ProfileFile: types.StringValue("config")},
+			expectedError: "Profile File Without Profile",
+		},
+		"profile overridden by all attributes": {
+			config: BitwardenSecretsManagerProviderModel{
+				Profile:        types.StringValue("dev"),
+				ApiUrl:         types.StringValue("https://api.example.com"),
+				IdentityUrl:    types.StringValue("https://identity.example.com"),
+				AccessToken:    types.StringValue("token"),
+				OrganizationId: types.StringValue(mockOrgId),
+			},
+			expectedWarning: "Profile Is Not Used",
+		},
+		"invalid api url": {
+			config:        BitwardenSecretsManagerProviderModel{ApiUrl: types.StringValue("ftp://api.example.com")},
+			expectedError: "Invalid URI for Bitwarden Secrets Manager API endpoint",
+		},
+		"unknown identity url": {
+			config: BitwardenSecretsManagerProviderModel{IdentityUrl: types.StringUnknown()},
+		},
+		"region with api url": {
+			config:        BitwardenSecretsManagerProviderModel{Region: types.StringValue("eu"), ApiUrl: types.StringValue("https://api.example.com")},
+			expectedError: "Conflicting Endpoint Configuration",
+		},
+		"self-hosted with urls": {
+			config: BitwardenSecretsManagerProviderModel{
+				Region:      types.StringValue("self-hosted"),
+				ApiUrl:      types.StringValue("https://api.example.com"),
+				IdentityUrl: types.StringValue("https://identity.example.com"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := New("test")().(provider.ProviderWithValidateConfig)
+			resp := provider.ValidateConfigResponse{}
+			p.ValidateConfig(context.Background(), provider.ValidateConfigRequest{Config: newTestProviderConfig(t, p, test.config)}, &resp)
+
+			if test.expectedError == "" && resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if test.expectedError != "" && !diagnosticsContain(resp.Diagnostics.Errors(), test.expectedError) {
+				t.Fatalf("expected error %q, got: %v", test.expectedError, resp.Diagnostics)
+			}
+			if test.expectedWarning != "" && !diagnosticsContain(resp.Diagnostics.Warnings(), test.expectedWarning) {
+				t.Fatalf("expected warning %q, got: %v", test.expectedWarning, resp.Diagnostics)
+			}
+			if test.expectedWarning == "" && resp.Diagnostics.WarningsCount() > 0 {
+				t.Fatalf("unexpected warning: %v", resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestProviderConfigureRegion(t *testing.T) {
+	originalFactory := createBitwardenClient
+	var clientApiUrl, clientIdentityUrl string
+	createBitwardenClient = func(apiUrl *string, identityUrl *string) (sdk.BitwardenClientInterface, error) {
+		clientApiUrl, clientIdentityUrl = *apiUrl, *identityUrl
+		return newMockBitwardenClient(), nil
+	}
+	t.Cleanup(func() { createBitwardenClient = originalFactory })
+
+	t.Setenv("BW_API_URL", "https://api.example.com")
+	t.Setenv("BW_IDENTITY_API_URL", "https://identity.example.com")
+	t.Setenv("BW_ACCESS_TOKEN", "token")
+	t.Setenv("BW_ORGANIZATION_ID", mockOrgId)
+
+	tests := map[string]struct {
+		region              types.String
+		expectedApiUrl      string
+		expectedIdentityUrl string
+	}{
+		"us":          {region: types.StringValue("us"), expectedApiUrl: "https://api.bitwarden.com", expectedIdentityUrl: "https://identity.bitwarden.com"},
+		"eu":          {region: types.StringValue("eu"), expectedApiUrl: "https://api.bitwarden.eu", expectedIdentityUrl: "https://identity.bitwarden.eu"},
+		"self-hosted": {region: types.StringValue("self-hosted"), expectedApiUrl: "https://api.example.com", expectedIdentityUrl: "https://identity.example.com"},
+		"no region":   {region: types.StringNull(), expectedApiUrl: "https://api.example.com", expectedIdentityUrl: "https://identity.example.com"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := New("test")()
+			resp := provider.ConfigureResponse{}
+			p.Configure(context.Background(), provider.ConfigureRequest{Config: newTestProviderConfig(t, p, BitwardenSecretsManagerProviderModel{Region: test.region})}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if clientApiUrl != test.expectedApiUrl || clientIdentityUrl != test.expectedIdentityUrl {
+				t.Errorf("expected endpoints %s and %s, got: %s and %s", test.expectedApiUrl, test.expectedIdentityUrl, clientApiUrl, clientIdentityUrl)
+			}
+		})
+	}
+
+	t.Run("self-hosted without urls", func(t *testing.T) {
+		t.Setenv("BW_API_URL", "")
+		t.Setenv("BW_IDENTITY_API_URL", "")
+		p := New("test")()
+		resp := provider.ConfigureResponse{}
+		p.Configure(context.Background(), provider.ConfigureRequest{Config: newTestProviderConfig(t, p, BitwardenSecretsManagerProviderModel{Region: types.StringValue("self-hosted")})}, &resp)
+		if !diagnosticsContain(resp.Diagnostics, "Missing URI for Bitwarden Secrets Manager API endpoint") {
+			t.Fatalf("expected missing endpoint error, got: %v", resp.Diagnostics)
+		}
+	})
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// retryBudget bounds the total time a provider instance spends retrying transient errors, which includes the delays
+// between the attempts and the duration of the retried attempts. Terraform starts a provider instance for every plan
+// and apply, so the budget applies per run. Once the budget is exhausted, transient errors fail immediately. All
+// methods allow unlimited retries on a nil budget.
+type retryBudget struct {
+	mu        sync.Mutex
+	total     time.Duration
+	remaining time.Duration
+}
+
+func newRetryBudget(total time.Duration) *retryBudget {
+	return &retryBudget{total: total, remaining: total}
+}
+
+// reserve deducts the delay before a retry from the budget and reports whether the budget suffices for it.
+func (b *retryBudget) reserve(delay time.Duration) bool {
+	if b == nil {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if delay > b.remaining {
+		b.remaining = 0
+		return false
+	}
+	b.remaining -= delay
+	return true
+}
+
+// charge deducts the duration of a retried attempt from the budget.
+func (b *retryBudget) charge(duration time.Duration) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.remaining = max(b.remaining-duration, 0)
+}
+
+// retryBudgetExhaustedError is returned instead of a transient error which was not retried because the retry budget
+// was exhausted.
+type retryBudgetExhaustedError struct {
+	budget time.Duration
+	err    error
+}
+
+func (e *retryBudgetExhaustedError) Error() string {
+	return fmt.Sprintf("the retry budget of %s is exhausted, so the transient error was not retried: %v", e.budget, e.err)
+}
+
+func (e *retryBudgetExhaustedError) Unwrap() error {
+	return e.err
+}
@@ -2,7 +2,6 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/bitwarden/sdk-go/v2"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -28,6 +27,8 @@ func NewSecretDataSource() datasource.DataSource {
 type secretDataSource struct {
 	bitwardenClient sdk.BitwardenClientInterface
 	organizationId  string
+	verboseErrors   bool
+	logLevel        string
 }
 
 type secretDataSourceModel struct {
@@ -39,6 +40,9 @@ type secretDataSourceModel struct {
 	OrganizationID types.String `tfsdk:"organization_id"`
 	CreationDate   types.String `tfsdk:"creation_date"`
 	RevisionDate   types.String `tfsdk:"revision_date"`
+	// UseDefaultOnMissing and DefaultValue are not sent to Bitwarden Secrets Manager and only apply to missing secrets.
+	UseDefaultOnMissing types.Bool   `tfsdk:"use_default_on_missing"`
+	DefaultValue        types.String `tfsdk:"default_value"`
 }
 
 func (s *secretDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -89,59 +93,48 @@ func (s *secretDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Computed:    true,
 			},
 			"revision_date": schema.StringAttribute{
-				Description: "String representation of the revision date of the secret.",
-				Computed:    true,
+				Description: "String representation of the revision date of the secret. Bitwarden Secrets Manager does not expose a revision counter. " +
+					"The revision date changes whenever the secret is modified and can be compared to detect concurrent modifications.",
+				Computed: true,
+			},
+			"use_default_on_missing": schema.BoolAttribute{
+				Description: "When set to true, a secret which does not exist or is not accessible by the used machine account does not fail the read. " +
+					"Instead, value is set to default_value and all other computed attributes are null. The provided default is false.",
+				MarkdownDescription: "When set to `true`, a secret which does not exist or is not accessible by the used machine account does not fail the read. " +
+					"Instead, `value` is set to `default_value` and all other computed attributes are `null`. The provided default is `false`.",
+				Optional: true,
+			},
+			"default_value": schema.StringAttribute{
+				Description:         "The value used if the secret is missing and use_default_on_missing is true. This attribute is sensitive.",
+				MarkdownDescription: "The `value` used if the secret is missing and `use_default_on_missing` is `true`. This attribute is sensitive.",
+				Optional:            true,
+				Sensitive:           true,
 			},
 		},
 	}
 }
 
 func (s *secretDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	// Add a nil check when handling BitwardenSecretsManagerProviderDataStruct because Terraform
-	// sets that data after it calls the ConfigureProvider RPC.
-	tflog.Info(ctx, "Configuring Secret Datasource")
-	if req.ProviderData == nil {
-		tflog.Debug(ctx, "Skipping Datasource Configuration because Provider has not been configured yet.")
-		return
-	}
-
-	providerDataStruct, ok := req.ProviderData.(BitwardenSecretsManagerProviderDataStruct)
+	providerDataStruct, ok := configureClient(ctx, req.ProviderData, &resp.Diagnostics)
 	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected sdk.BitwardenClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
-		return
-	}
-
-	client := providerDataStruct.bitwardenClient
-	organizationId := providerDataStruct.organizationId
-
-	if client == nil {
-		resp.Diagnostics.AddError(
-			"Client Not Initialized",
-			"The Bitwarden client was not properly initialized due to a missing Bitwarden API Client.",
-		)
-		return
-	}
-
-	if organizationId == "" {
-		resp.Diagnostics.AddError(
-			"Client Not Initialized",
-			"The Bitwarden client was not properly initialized due to an empty Organization ID.",
-		)
 		return
 	}
+	ctx = newLogContext(ctx, providerDataStruct.logLevel)
+	tflog.SubsystemInfo(ctx, logSubsystem, "Configuring Secret Datasource")
 
-	s.bitwardenClient = client
-	s.organizationId = organizationId
+	s.bitwardenClient = providerDataStruct.bitwardenClient
+	s.organizationId = providerDataStruct.organizationId
+	s.verboseErrors = providerDataStruct.verboseErrors
+	s.logLevel = providerDataStruct.logLevel
 
-	tflog.Info(ctx, "Datasource Configured")
+	tflog.SubsystemInfo(ctx, logSubsystem, "Datasource Configured")
 }
 
 func (s *secretDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	tflog.Info(ctx, "Reading Secret Datasource")
+	ctx, correlationId := newCorrelationContext(ctx, s.logLevel)
+	defer appendCorrelationId(&resp.Diagnostics, correlationId)
+
+	tflog.SubsystemInfo(ctx, logSubsystem, "Reading Secret Datasource")
 
 	var state secretDataSourceModel
 	diags := req.Config.Get(ctx, &state)
@@ -150,6 +143,8 @@ func (s *secretDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
+	defer recoverFromPanic(ctx, "Read Secret", state.ID.ValueString(), &resp.Diagnostics)
+
 	if s.bitwardenClient == nil {
 		resp.Diagnostics.AddError(
 			"Client Not Initialized",
@@ -158,22 +153,34 @@ func (s *secretDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	secret, err := s.bitwardenClient.Secrets().Get(state.ID.ValueString())
+	if !checkContext(ctx, "Read Secret", &resp.Diagnostics) {
+		return
+	}
+
+	secret, err := readSecret(s.bitwardenClient, state.ID.ValueString())
+	if err != nil && state.UseDefaultOnMissing.ValueBool() && isNotFoundError(err.Error()) {
+		tflog.SubsystemInfo(ctx, logSubsystem, "Secret not found, using default value", map[string]any{"id": state.ID.ValueString()})
+		state.Value = state.DefaultValue
+		state.Key = types.StringNull()
+		state.Note = types.StringNull()
+		state.ProjectID = types.StringNull()
+		state.OrganizationID = types.StringNull()
+		state.CreationDate = types.StringNull()
+		state.RevisionDate = types.StringNull()
+
+		diags = resp.State.Set(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to Read Secret with id: "+state.ID.ValueString(),
-			err.Error(),
-		)
+		addReadSecretError(&resp.Diagnostics, state.ID.ValueString(), err, s.organizationId, s.verboseErrors)
 		return
 	}
 
 	state.Key = types.StringValue(secret.Key)
 	state.Value = types.StringValue(secret.Value)
 	state.Note = types.StringValue(secret.Note)
-	state.ProjectID = types.StringValue(*secret.ProjectID)
-	state.OrganizationID = types.StringValue(secret.OrganizationID)
-	state.CreationDate = types.StringValue(secret.CreationDate.String())
-	state.RevisionDate = types.StringValue(secret.RevisionDate.String())
+	state.ProjectID, state.OrganizationID, state.CreationDate, state.RevisionDate = secretMetadata(secret)
 
 	// Set state
 	diags = resp.State.Set(ctx, &state)
@@ -1,6 +1,11 @@
 package provider
 
 import (
+	"context"
+	"github.com/bitwarden/sdk-go/v2"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"regexp"
@@ -107,3 +112,58 @@ func TestAccDatasourceSecretVerifySecretData(t *testing.T) {
 		},
 	})
 }
+
+func TestSecretDataSourceReadWithEmptyResponse(t *testing.T) {
+	client := newMockBitwardenClient()
+	client.secretGetHook = func(secretID string) (*sdk.SecretResponse, error) {
+		return nil, nil
+	}
+	d := &secretDataSource{bitwardenClient: client, organizationId: mockOrgId}
+	schema := dataSourceTestSchema(t, d)
+
+	req := datasource.ReadRequest{Config: newTestConfig(t, schema, secretDataSourceModel{ID: types.StringValue(validProjectUUID)})}
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: schema}}
+	d.Read(context.Background(), req, &resp)
+
+	if !diagnosticsContain(resp.Diagnostics, "Unexpected Bitwarden Secrets Manager Response") {
+		t.Fatalf("expected diagnostic about unexpected response, got: %v", resp.Diagnostics)
+	}
+}
+
+func TestSecretDataSourceReadMissingSecretWithDefault(t *testing.T) {
+	tests := map[string]struct {
+		useDefaultOnMissing types.Bool
+		expectError         bool
+	}{
+		"strict":          {useDefaultOnMissing: types.BoolNull(), expectError: true},
+		"default enabled": {useDefaultOnMissing: types.BoolValue(true)},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			d := &secretDataSource{bitwardenClient: newMockBitwardenClient(), organizationId: mockOrgId}
+			schema := dataSourceTestSchema(t, d)
+
+			req := datasource.ReadRequest{Config: newTestConfig(t, schema, secretDataSourceModel{
+				ID:                  types.StringValue(validProjectUUID),
+				UseDefaultOnMissing: test.useDefaultOnMissing,
+				DefaultValue:        types.StringValue("fallback"),
+			})}
+			resp := datasource.ReadResponse{State: tfsdk.State{Schema: schema}}
+			d.Read(context.Background(), req, &resp)
+
+			if resp.Diagnostics.HasError() != test.expectError {
+				t.Fatalf("expected error to be %t, got: %v", test.expectError, resp.Diagnostics)
+			}
+			if test.expectError {
+				return
+			}
+
+			var state secretDataSourceModel
+			resp.State.Get(context.Background(), &state)
+			if state.Value.ValueString() != "fallback" || !state.Key.IsNull() {
+				t.Fatalf("expected the default value for the missing secret, got: %v", state)
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/bitwarden/sdk-go/v2"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ datasource.DataSource              = &secretPatternCheckDataSource{}
+	_ datasource.DataSourceWithConfigure = &secretPatternCheckDataSource{}
+
+	_ validator.String = &stringRegexpValidator{}
+)
+
+func NewSecretPatternCheckDataSource() datasource.DataSource {
+	return &secretPatternCheckDataSource{}
+}
+
+// secretPatternCheckDataSource defines the data source implementation. It verifies the value of a secret against a
+// regular expression without exposing the value.
+type secretPatternCheckDataSource struct {
+	bitwardenClient sdk.BitwardenClientInterface
+	organizationId  string
+	verboseErrors   bool
+	logLevel        string
+	redactKeys      bool
+}
+
+type secretPatternCheckDataSourceModel struct {
+	SecretID       types.String `tfsdk:"secret_id"`
+	Pattern        types.String `tfsdk:"pattern"`
+	FailOnMismatch types.Bool   `tfsdk:"fail_on_mismatch"`
+	ErrorMessage   types.String `tfsdk:"error_message"`
+	Key            types.String `tfsdk:"key"`
+	Matches        types.Bool   `tfsdk:"matches"`
+}
+
+func (d *secretPatternCheckDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret_pattern_check"
+}
+
+func (d *secretPatternCheckDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The secret_pattern_check data source verifies that the value of a secret matches a regular expression and fails the plan otherwise, e.g. to enforce format policies. " +
+			"The value of the secret is neither stored in the Terraform state nor shown in diagnostics.",
+		MarkdownDescription: "The `secret_pattern_check` data source verifies that the `value` of a secret matches a regular expression and fails the plan otherwise, e.g. to enforce format policies. " +
+			"The `value` of the secret is neither stored in the Terraform state nor shown in diagnostics.",
+		Attributes: map[string]schema.Attribute{
+			"secret_id": schema.StringAttribute{
+				Description:         "String representation of the ID of the secret whose value is verified.",
+				MarkdownDescription: "String representation of the `ID` of the secret whose `value` is verified.",
+				Required:            true,
+				Validators: []validator.String{
+					stringUUIDValidate(),
+				},
+			},
+			"pattern": schema.StringAttribute{
+				Description: "The regular expression in RE2 syntax which the value of the secret must match. The expression matches any part of the value, " +
+					"so it must be anchored with ^ and $ to match the whole value.",
+				MarkdownDescription: "The regular expression in [RE2 syntax](https://github.com/google/re2/wiki/Syntax) which the `value` of the secret must match. The expression matches any part of the `value`, " +
+					"so it must be anchored with `^` and `$` to match the whole `value`.",
+				Required: true,
+				Validators: []validator.String{
+					stringRegexpValidator{},
+				},
+			},
+			"fail_on_mismatch": schema.BoolAttribute{
+				Description: "When set to true, a value which does not match the pattern fails the plan with an error, otherwise it is reported as a warning and in matches. " +
+					"The provided default is true.",
+				MarkdownDescription: "When set to `true`, a `value` which does not match the `pattern` fails the plan with an error, otherwise it is reported as a warning and in `matches`. " +
+					"The provided default is `true`.",
+				Optional: true,
+			},
+			"error_message": schema.StringAttribute{
+				Description:         "A message which explains the expected format, added to the diagnostic of a value which does not match the pattern.",
+				MarkdownDescription: "A message which explains the expected format, added to the diagnostic of a `value` which does not match the `pattern`.",
+				Optional:            true,
+			},
+			"key": schema.StringAttribute{
+				Description:         "String representation of the key of the verified secret.",
+				MarkdownDescription: "String representation of the `key` of the verified secret.",
+				Computed:            true,
+			},
+			"matches": schema.BoolAttribute{
+				Description:         "Whether the value of the secret matches the pattern.",
+				MarkdownDescription: "Whether the `value` of the secret matches the `pattern`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *secretPatternCheckDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	providerDataStruct, ok := configureClient(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	ctx = newLogContext(ctx, providerDataStruct.logLevel)
+	tflog.SubsystemInfo(ctx, logSubsystem, "Configuring Secret Pattern Check Datasource")
+
+	d.bitwardenClient = providerDataStruct.bitwardenClient
+	d.organizationId = providerDataStruct.organizationId
+	d.verboseErrors = providerDataStruct.verboseErrors
+	d.logLevel = providerDataStruct.logLevel
+	d.redactKeys = providerDataStruct.redactKeys
+
+	tflog.SubsystemInfo(ctx, logSubsystem, "Datasource Configured")
+}
+
+func (d *secretPatternCheckDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, correlationId := newCorrelationContext(ctx, d.logLevel)
+	defer appendCorrelationId(&resp.Diagnostics, correlationId)
+
+	tflog.SubsystemInfo(ctx, logSubsystem, "Reading Secret Pattern Check Datasource")
+
+	var state secretPatternCheckDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer recoverFromPanic(ctx, "Check Secret Pattern", state.SecretID.ValueString(), &resp.Diagnostics)
+
+	if d.bitwardenClient == nil {
+		resp.Diagnostics.AddError(
+			"Client Not Initialized",
+			"The Bitwarden client was not properly initialized.",
+		)
+		return
+	}
+
+	if !checkContext(ctx, "Check Secret Pattern", &resp.Diagnostics) {
+		return
+	}
+
+	pattern, err := regexp.Compile(state.Pattern.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("pattern"), "Invalid Regular Expression", err.Error())
+		return
+	}
+
+	secret, err := readSecret(d.bitwardenClient, state.SecretID.ValueString())
+	if err != nil {
+		addReadSecretError(&resp.Diagnostics, state.SecretID.ValueString(), err, d.organizationId, d.verboseErrors)
+		return
+	}
+
+	state.Key = types.StringValue(secret.Key)
+	state.Matches = types.BoolValue(pattern.MatchString(secret.Value))
+	tflog.SubsystemDebug(ctx, logSubsystem, "Checked secret value against pattern", map[string]any{
+		"id":      secret.ID,
+		"matches": state.Matches.ValueBool(),
+	})
+
+	if !state.Matches.ValueBool() {
+		detail := fmt.Sprintf("The value of the secret with the key \"%s\" and the id: %s does not match the pattern: %s",
+			displaySecretKey(secret.Key, d.redactKeys), secret.ID, state.Pattern.ValueString())
+		if state.ErrorMessage.ValueString() != "" {
+			detail += "\n\n" + state.ErrorMessage.ValueString()
+		}
+		if state.FailOnMismatch.IsNull() || state.FailOnMismatch.ValueBool() {
+			resp.Diagnostics.AddAttributeError(path.Root("pattern"), "Secret Value Does Not Match Pattern", detail)
+			return
+		}
+		resp.Diagnostics.AddAttributeWarning(path.Root("pattern"), "Secret Value Does Not Match Pattern", detail)
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// stringRegexpValidator validates that a string is a regular expression in RE2 syntax.
+type stringRegexpValidator struct{}
+
+func (v stringRegexpValidator) Description(_ context.Context) string {
+	return "the string parameter must be a valid regular expression"
+}
+
+func (v stringRegexpValidator) MarkdownDescription(_ context.Context) string {
+	return "the string parameter must be a valid regular expression in [RE2 syntax](https://github.com/google/re2/wiki/Syntax)"
+}
+
+func (v stringRegexpValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	// If the value is unknown or null, there is nothing to validate.
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	if _, err := regexp.Compile(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Regular Expression",
+			fmt.Sprintf("the provided string: %s is not a valid regular expression: %s", req.ConfigValue.ValueString(), err),
+		)
+	}
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+	"testing"
+)
+
+func TestSecretPatternCheckDataSourceRead(t *testing.T) {
+	client := newMockBitwardenClient()
+	secret := client.addSecret("DATABASE_URL", "postgres://app:hunter2@db:5432/app", "", mockOrgId, validProjectUUID)
+
+	d := &secretPatternCheckDataSource{bitwardenClient: client, organizationId: mockOrgId}
+	schema := dataSourceTestSchema(t, d)
+
+	tests := map[string]struct {
+		pattern        string
+		failOnMismatch types.Bool
+		expectMatches  bool
+		expectError    bool
+		expectWarning  bool
+	}{
+		"matches":                   {pattern: `^postgres://[^:]+:[^@]+@[^/]+/\w+$`, expectMatches: true},
+		"does not match":            {pattern: `^mysql://`, expectError: true},
+		"does not match as warning": {pattern: `^mysql://`, failOnMismatch: types.BoolValue(false), expectWarning: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := secretPatternCheckDataSourceModel{
+				SecretID:       types.StringValue(secret.ID),
+				Pattern:        types.StringValue(test.pattern),
+				FailOnMismatch: test.failOnMismatch,
+				ErrorMessage:   types.StringValue("The database URL must use PostgreSQL."),
+			}
+			resp := datasource.ReadResponse{State: tfsdk.State{Schema: schema}}
+			d.Read(context.Background(), datasource.ReadRequest{Config: newTestConfig(t, schema, config)}, &resp)
+
+			if resp.Diagnostics.HasError() != test.expectError || (resp.Diagnostics.WarningsCount() > 0) != test.expectWarning {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			for _, diagnostic := range resp.Diagnostics {
+				if strings.Contains(diagnostic.Detail(), secret.Value) {
+					t.Errorf("expected the value not to be exposed, got: %s", diagnostic.Detail())
+				}
+				if !strings.Contains(diagnostic.Detail(), "must use PostgreSQL") {
+					t.Errorf("expected the error message in the diagnostic, got: %s", diagnostic.Detail())
+				}
+			}
+			if test.expectError {
+				return
+			}
+
+			var state secretPatternCheckDataSourceModel
+			resp.State.Get(context.Background(), &state)
+			if state.Matches.ValueBool() != test.expectMatches || state.Key.ValueString() != "DATABASE_URL" {
+				t.Errorf("unexpected state: %+v", state)
+			}
+		})
+	}
+}
+
+func TestStringRegexpValidator(t *testing.T) {
+	for pattern, valid := range map[string]bool{`^\d+$`: true, `^(unclosed`: false} {
+		resp := validator.StringResponse{}
+		stringRegexpValidator{}.ValidateString(context.Background(), validator.StringRequest{ConfigValue: types.StringValue(pattern)}, &resp)
+		if resp.Diagnostics.HasError() == valid {
+			t.Errorf("expected %s to be valid: %t, got: %v", pattern, valid, resp.Diagnostics)
+		}
+	}
+}
@@ -1,11 +1,26 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/bitwarden/sdk-go/v2"
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -14,15 +29,21 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
 	// Ensure provider defined types fully satisfy framework interfaces.
-	_ resource.Resource                = &secretResource{}
-	_ resource.ResourceWithConfigure   = &secretResource{}
-	_ resource.ResourceWithImportState = &secretResource{}
+	_ resource.Resource                   = &secretResource{}
+	_ resource.ResourceWithConfigure      = &secretResource{}
+	_ resource.ResourceWithImportState    = &secretResource{}
+	_ resource.ResourceWithValidateConfig = &secretResource{}
+	_ resource.ResourceWithModifyPlan     = &secretResource{}
+
+	// sha256Pattern matches hex-encoded SHA-256 hashes.
+	sha256Pattern = regexp.MustCompile(`^[0-9a-fA-F]{64}$`)
 )
 
 // NewSecretResource is a helper function to simplify the provider implementation.
@@ -32,8 +53,18 @@ func NewSecretResource() resource.Resource {
 
 // secretResource defines the data source implementation.
 type secretResource struct {
-	bitwardenClient sdk.BitwardenClientInterface
-	organizationId  string
+	bitwardenClient             sdk.BitwardenClientInterface
+	organizationId              string
+	ignoreMissingOnDelete       bool
+	verboseErrors               bool
+	logLevel                    string
+	redactKeys                  bool
+	refreshTtl                  time.Duration
+	summary                     *operationSummary
+	warnValueInState            bool
+	validateProjectOrganization bool
+	metadataOnly                bool
+	projectCache                *projectCache
 }
 
 type secretResourceModel struct {
@@ -55,6 +86,28 @@ type secretResourceModel struct {
 	Numbers        types.Bool   `tfsdk:"numbers"`
 	Special        types.Bool   `tfsdk:"special"`
 	Uppercase      types.Bool   `tfsdk:"uppercase"`
+	// AllowWhitespaceKeys is not sent to Bitwarden Secrets Manager and only affects the validation of the key.
+	AllowWhitespaceKeys types.Bool `tfsdk:"allow_whitespace_keys"`
+	// KeyCase is not sent to Bitwarden Secrets Manager and only normalizes the key before it is sent.
+	KeyCase types.String `tfsdk:"key_case"`
+	// TrackValueByHash is not sent to Bitwarden Secrets Manager and only affects how the value is stored in the state.
+	TrackValueByHash types.Bool `tfsdk:"track_value_by_hash"`
+	// ValueFromSecretID and SourceValueSha256 are not sent to Bitwarden Secrets Manager and only determine the value.
+	ValueFromSecretID types.String `tfsdk:"value_from_secret_id"`
+	SourceValueSha256 types.String `tfsdk:"source_value_sha256"`
+	ContentVersion    types.String `tfsdk:"content_version"`
+	ValueIsEmpty      types.Bool   `tfsdk:"value_is_empty"`
+	ValueLength       types.Int64  `tfsdk:"value_length"`
+	// ExpectedValueSha256 is not sent to Bitwarden Secrets Manager and only verifies the existing value.
+	ExpectedValueSha256 types.String `tfsdk:"expected_value_sha256"`
+	// ProjectName and CreateProjectIfMissing are not sent to Bitwarden Secrets Manager and only resolve the project.
+	ProjectName            types.String `tfsdk:"project_name"`
+	CreateProjectIfMissing types.Bool   `tfsdk:"create_project_if_missing"`
+	// ForceNewValueOnImport is not sent to Bitwarden Secrets Manager and only affects the first apply after an import.
+	ForceNewValueOnImport types.Bool `tfsdk:"force_new_value_on_import"`
+	// RotationIntervalDays is stored as the last line of the note in Bitwarden Secrets Manager.
+	RotationIntervalDays types.Int64  `tfsdk:"rotation_interval_days"`
+	NextRotationAt       types.String `tfsdk:"next_rotation_at"`
 }
 
 func (s *secretResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -79,6 +132,23 @@ func (s *secretResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				MarkdownDescription: "String representation of the `key` of the secret. Inside Bitwarden Secrets Manager this is called \"name\".",
 				Required:            true,
 			},
+			"allow_whitespace_keys": schema.BoolAttribute{
+				Description: "When set to true, the key of the secret may contain leading or trailing whitespace, which is rejected otherwise. " +
+					"Control characters such as newlines are always rejected. Only intended for legacy secrets. The provided default is false.",
+				MarkdownDescription: "When set to `true`, the `key` of the secret may contain leading or trailing whitespace, which is rejected otherwise. " +
+					"Control characters such as newlines are always rejected. Only intended for legacy secrets. The provided default is `false`.",
+				Optional: true,
+			},
+			"key_case": schema.StringAttribute{
+				Description: "Normalizes the case of the key before the secret is created or updated. Must be one of preserve, upper or lower. " +
+					"The configured key is kept in the Terraform state as long as Bitwarden Secrets Manager stores its normalized form. The provided default is preserve.",
+				MarkdownDescription: "Normalizes the case of the `key` before the secret is created or updated. Must be one of `preserve`, `upper` or `lower`. " +
+					"The configured `key` is kept in the Terraform state as long as Bitwarden Secrets Manager stores its normalized form. The provided default is `preserve`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("preserve", "upper", "lower"),
+				},
+			},
 			"value": schema.StringAttribute{
 				Description:         "String representation of the value of the secret inside Bitwarden Secrets Manager. This attribute is sensitive. The Dynamic Secrets feature enables compatibility with secret value changes in Bitwarden Secrets Manager without changes to the terraform plan.",
 				MarkdownDescription: "String representation of the `value` of the secret inside Bitwarden Secrets Manager. This attribute is sensitive. The Dynamic Secrets feature enables compatibility with secret `value` changes in Bitwarden Secrets Manager without changes to the terraform plan.",
@@ -86,17 +156,143 @@ func (s *secretResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"track_value_by_hash": schema.BoolAttribute{
+				Description: "When set to true, only the SHA-256 hash of the value is stored in the value attribute of the Terraform state instead of the value itself. " +
+					"The live value is re-read on every refresh, so changes in Bitwarden Secrets Manager are still detected. " +
+					"Inspecting the state can no longer reveal the value, which therefore can only be consumed through the secret data source. " +
+					"Only supported for generated values, because explicitly configured values must be stored as configured. The provided default is false.",
+				MarkdownDescription: "When set to `true`, only the `SHA-256` hash of the value is stored in the `value` attribute of the Terraform state instead of the value itself. " +
+					"The live value is re-read on every refresh, so changes in Bitwarden Secrets Manager are still detected. " +
+					"Inspecting the state can no longer reveal the value, which therefore can only be consumed through the `secret` data source. " +
+					"Only supported for generated values, because explicitly configured values must be stored as configured. The provided default is `false`.",
+				Optional: true,
+			},
+			"expected_value_sha256": schema.StringAttribute{
+				Description: "The hex-encoded SHA-256 hash which the value of an existing secret in Bitwarden Secrets Manager is expected to have, e.g. to verify an imported secret. " +
+					"Every plan fails with an error while the current value does not match, without revealing the value. " +
+					"With an import block, a mismatch fails the plan before the imported secret is stored in the Terraform state. " +
+					"Update or remove this attribute when the value is changed intentionally.",
+				MarkdownDescription: "The hex-encoded `SHA-256` hash which the `value` of an existing secret in Bitwarden Secrets Manager is expected to have, e.g. to verify an imported secret. " +
+					"Every plan fails with an error while the current `value` does not match, without revealing the `value`. " +
+					"With an `import` block, a mismatch fails the plan before the imported secret is stored in the Terraform state. " +
+					"Update or remove this attribute when the `value` is changed intentionally.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(sha256Pattern, "must be a hex-encoded SHA-256 hash"),
+				},
+			},
+			"force_new_value_on_import": schema.BoolAttribute{
+				Description: "When set to true, the value of an imported secret is not adopted from Bitwarden Secrets Manager. " +
+					"The first apply after the import replaces it with the configured value, or with a newly generated value if value is not configured. " +
+					"Has no effect on secrets created by Terraform. The provided default is false.",
+				MarkdownDescription: "When set to `true`, the `value` of an imported secret is not adopted from Bitwarden Secrets Manager. " +
+					"The first apply after the import replaces it with the configured `value`, or with a newly generated `value` if `value` is not configured. " +
+					"Has no effect on secrets created by Terraform. The provided default is `false`.",
+				Optional: true,
+			},
+			"rotation_interval_days": schema.Int64Attribute{
+				Description: "The number of days after which the value of the secret is due for rotation, e.g. by an external rotator. " +
+					"Bitwarden Secrets Manager has no metadata fields, so the interval is stored as the last line of the note in the format \"rotation-interval-days: <days>\", " +
+					"which is not part of note in the Terraform state. Removing the attribute removes the line. A plan warns once the secret is overdue.",
+				MarkdownDescription: "The number of days after which the `value` of the secret is due for rotation, e.g. by an external rotator. " +
+					"Bitwarden Secrets Manager has no metadata fields, so the interval is stored as the last line of the `note` in the format `rotation-interval-days: <days>`, " +
+					"which is not part of `note` in the Terraform state. Removing the attribute removes the line. A plan warns once the secret is overdue.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"next_rotation_at": schema.StringAttribute{
+				Description: "The time at which the secret is due for rotation, i.e. its revision_date plus rotation_interval_days. " +
+					"The revision_date changes on every update of the secret, including updates of its note.",
+				MarkdownDescription: "The time at which the secret is due for rotation, i.e. its `revision_date` plus `rotation_interval_days`. " +
+					"The `revision_date` changes on every update of the secret, including updates of its `note`.",
+				Computed: true,
+			},
+			"value_from_secret_id": schema.StringAttribute{
+				Description: "String representation of the ID of another secret whose value is copied into this secret on create and update. " +
+					"Changes of the value of the source secret are detected during the plan and copied by the following apply. " +
+					"The used machine account requires read access to the source secret. Conflicts with value.",
+				MarkdownDescription: "String representation of the `ID` of another secret whose `value` is copied into this secret on create and update. " +
+					"Changes of the `value` of the source secret are detected during the plan and copied by the following apply. " +
+					"The used machine account requires read access to the source secret. Conflicts with `value`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringUUIDValidate(),
+				},
+			},
+			"source_value_sha256": schema.StringAttribute{
+				Description: "The SHA-256 hash of the value of the secret referenced by value_from_secret_id, as of the last copy. " +
+					"It is sensitive, because weak values can be recovered from their unsalted hash.",
+				MarkdownDescription: "The `SHA-256` hash of the `value` of the secret referenced by `value_from_secret_id`, as of the last copy. " +
+					"It is sensitive, because weak values can be recovered from their unsalted hash.",
+				Computed:  true,
+				Sensitive: true,
+			},
+			"content_version": schema.StringAttribute{
+				Description: "A hash of the value and the note of the secret keyed with a random salt of the resource, which changes if and only if one of them changes. " +
+					"It is not sensitive and can be used in lifecycle.replace_triggered_by of other resources.",
+				MarkdownDescription: "A hash of the `value` and the `note` of the secret keyed with a random salt of the resource, which changes if and only if one of them changes. " +
+					"It is not sensitive and can be used in `lifecycle.replace_triggered_by` of other resources.",
+				Computed: true,
+			},
+			"value_is_empty": schema.BoolAttribute{
+				Description: "Whether the value of the secret stored in Bitwarden Secrets Manager is empty. " +
+					"It is not sensitive and is read from the current value on every refresh, e.g. to detect placeholder secrets.",
+				MarkdownDescription: "Whether the `value` of the secret stored in Bitwarden Secrets Manager is empty. " +
+					"It is not sensitive and is read from the current `value` on every refresh, e.g. to detect placeholder secrets.",
+				Computed: true,
+			},
+			"value_length": schema.Int64Attribute{
+				Description: "The number of characters of the value of the secret stored in Bitwarden Secrets Manager. " +
+					"It is not sensitive and is planned from the configured value, so that reviewers can spot empty or truncated values in plans without seeing them.",
+				MarkdownDescription: "The number of characters of the `value` of the secret stored in Bitwarden Secrets Manager. " +
+					"It is not sensitive and is planned from the configured `value`, so that reviewers can spot empty or truncated values in plans without seeing them.",
+				Computed: true,
+			},
 			"note": schema.StringAttribute{
-				Description:         "String representation of the note of the secret inside Bitwarden Secrets Manager.",
-				MarkdownDescription: "String representation of the `note` of the secret inside Bitwarden Secrets Manager.",
-				Computed:            true,
-				Optional:            true,
+				Description: "String representation of the note of the secret inside Bitwarden Secrets Manager. " +
+					"If not configured, the note stored in Bitwarden Secrets Manager is kept, while an empty note clears it. " +
+					"Bitwarden Secrets Manager does not distinguish a missing note from an empty one, so a secret without a note has an empty note in the Terraform state, never null.",
+				MarkdownDescription: "String representation of the `note` of the secret inside Bitwarden Secrets Manager. " +
+					"If not configured, the `note` stored in Bitwarden Secrets Manager is kept, while an empty `note` clears it. " +
+					"Bitwarden Secrets Manager does not distinguish a missing `note` from an empty one, so a secret without a `note` has an empty `note` in the Terraform state, never `null`.",
+				Computed: true,
+				Optional: true,
 			},
 			"project_id": schema.StringAttribute{
-				Description:         "String representation of the ID of the project to which the secrets belongs. If the used machine account has no read access to this project, access will not be granted.",
-				MarkdownDescription: "String representation of the `ID` of the project to which the secret belongs. If the used machine account has no read access to this project, access will not be granted.",
-				Computed:            true,
-				Optional:            true,
+				Description: "String representation of the ID of the project to which the secrets belongs. If the used machine account has no read access to this project, access will not be granted. " +
+					"Changing the project moves the secret in place and keeps its ID, value and note. The machine account requires write access to both projects.",
+				MarkdownDescription: "String representation of the `ID` of the project to which the secret belongs. If the used machine account has no read access to this project, access will not be granted. " +
+					"Changing the project moves the secret in place and keeps its `ID`, `value` and `note`. The machine account requires write access to both projects.",
+				Computed: true,
+				Optional: true,
+				Validators: []validator.String{
+					stringUUIDValidate(),
+				},
+			},
+			"project_name": schema.StringAttribute{
+				Description: "The name of the project to which the secret belongs, as an alternative to project_id. " +
+					"The name is resolved among the projects of the organization configured on the provider which the machine account can read, and must be unique among them. " +
+					"The resolved project is kept while project_name is unchanged, so renaming the project outside of Terraform does not move the secret. " +
+					"Changing project_name moves the secret to the project with the new name. Conflicts with project_id.",
+				MarkdownDescription: "The name of the project to which the secret belongs, as an alternative to `project_id`. " +
+					"The name is resolved among the projects of the `organization_id` configured on the provider which the machine account can read, and must be unique among them. " +
+					"The resolved project is kept while `project_name` is unchanged, so renaming the project outside of Terraform does not move the secret. " +
+					"Changing `project_name` moves the secret to the project with the new name. Conflicts with `project_id`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"create_project_if_missing": schema.BoolAttribute{
+				Description: "When set to true, a project named project_name is created before the secret if no such project exists. " +
+					"The project is not managed by this resource: it is neither deleted when the secret is destroyed nor when the creation of the secret fails. " +
+					"The machine account requires permission to create projects. Requires project_name to be set. The provided default is false.",
+				MarkdownDescription: "When set to `true`, a project named `project_name` is created before the secret if no such project exists. " +
+					"The project is not managed by this resource: it is neither deleted when the secret is destroyed nor when the creation of the secret fails. " +
+					"The machine account requires permission to create projects. Requires `project_name` to be set. The provided default is `false`.",
+				Optional: true,
 			},
 			"organization_id": schema.StringAttribute{
 				Description:         "String representation of the ID of the organization to which the secrets belongs.",
@@ -114,8 +310,9 @@ func (s *secretResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				},
 			},
 			"revision_date": schema.StringAttribute{
-				Description: "String representation of the revision date of the secret.",
-				Computed:    true,
+				Description: "String representation of the revision date of the secret. Bitwarden Secrets Manager does not expose a revision counter. " +
+					"The revision date changes whenever the secret is modified and can be compared to detect concurrent modifications.",
+				Computed: true,
 			},
 			"avoid_ambiguous": schema.BoolAttribute{
 				Description:         "Ignored if value is provided explicitly or secret is updated dynamically in Bitwarden Secrets Manager. When set to true, the generated secret will not contain ambiguous characters. The ambiguous characters are: I, O, l, 0, 1. The provided default is false. ",
@@ -211,50 +408,36 @@ func (s *secretResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 }
 
 func (s *secretResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	// Add a nil check when handling BitwardenSecretsManagerProviderDataStruct because Terraform
-	// sets that data after it calls the ConfigureProvider RPC.
-	tflog.Info(ctx, "Configuring Secret Resource")
-	if req.ProviderData == nil {
-		tflog.Debug(ctx, "Skipping Resource Configuration because Provider has not been configured yet.")
-		return
-	}
-
-	providerDataStruct, ok := req.ProviderData.(BitwardenSecretsManagerProviderDataStruct)
+	providerDataStruct, ok := configureClient(ctx, req.ProviderData, &resp.Diagnostics)
 	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected sdk.BitwardenClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
 		return
 	}
+	ctx = newLogContext(ctx, providerDataStruct.logLevel)
+	tflog.SubsystemInfo(ctx, logSubsystem, "Configuring Secret Resource")
 
-	client := providerDataStruct.bitwardenClient
-	organizationId := providerDataStruct.organizationId
+	s.bitwardenClient = providerDataStruct.bitwardenClient
+	s.organizationId = providerDataStruct.organizationId
+	s.ignoreMissingOnDelete = providerDataStruct.ignoreMissingOnDelete
+	s.verboseErrors = providerDataStruct.verboseErrors
+	s.logLevel = providerDataStruct.logLevel
+	s.redactKeys = providerDataStruct.redactKeys
+	s.refreshTtl = providerDataStruct.refreshTtl
+	s.summary = providerDataStruct.summary
+	s.warnValueInState = providerDataStruct.warnValueInState
+	s.validateProjectOrganization = providerDataStruct.validateProjectOrganization
+	s.metadataOnly = providerDataStruct.metadataOnly
+	s.projectCache = providerDataStruct.projectCache
 
-	if client == nil {
-		resp.Diagnostics.AddError(
-			"Client Not Initialized",
-			"The Bitwarden client was not properly initialized due to a missing Bitwarden API Client.",
-		)
-		return
-	}
-
-	if organizationId == "" {
-		resp.Diagnostics.AddError(
-			"Client Not Initialized",
-			"The Bitwarden client was not properly initialized due to an empty Organization ID.",
-		)
-		return
-	}
-
-	s.bitwardenClient = client
-	s.organizationId = organizationId
-
-	tflog.Info(ctx, "Resource Configured")
+	tflog.SubsystemInfo(ctx, logSubsystem, "Resource Configured")
 }
 
 func (s *secretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, correlationId := newCorrelationContext(ctx, s.logLevel)
+	defer appendCorrelationId(&resp.Diagnostics, correlationId)
+	defer s.summary.recordIfSucceeded(ctx, "create", &resp.Diagnostics)
+
+	defer recoverFromPanic(ctx, "Create Secret", "", &resp.Diagnostics)
+
 	// Retrieve values from plan
 	var plan secretResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -271,8 +454,18 @@ func (s *secretResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	if !checkContext(ctx, "Create Secret", &resp.Diagnostics) {
+		return
+	}
+
 	var value string
-	if plan.Value.IsUnknown() {
+	if !plan.ValueFromSecretID.IsNull() {
+		sourceValue, ok := s.readSourceValue(plan.ValueFromSecretID.ValueString(), &resp.Diagnostics)
+		if !ok {
+			return
+		}
+		value = sourceValue
+	} else if plan.Value.IsUnknown() {
 		generatedValue, err := createSecretValue(&plan, s.bitwardenClient)
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -286,40 +479,67 @@ func (s *secretResource) Create(ctx context.Context, req resource.CreateRequest,
 		value = plan.Value.ValueString()
 	}
 
+	if !plan.ProjectName.IsNull() {
+		projectId, ok := s.resolveProjectName(ctx, plan.ProjectName.ValueString(), plan.CreateProjectIfMissing.ValueBool(), &resp.Diagnostics)
+		if !ok {
+			return
+		}
+		plan.ProjectID = types.StringValue(projectId)
+	}
+
+	if s.validateProjectOrganization && !s.verifyProjectOrganization(plan.ProjectID.ValueString(), &resp.Diagnostics) {
+		return
+	}
+
+	key := applyKeyCase(plan.Key.ValueString(), plan.KeyCase)
 	secret, err := s.bitwardenClient.Secrets().Create(
-		plan.Key.ValueString(),
+		key,
 		value,
-		plan.Note.ValueString(),
+		withRotationInterval(plan.Note.ValueString(), plan.RotationIntervalDays),
 		s.organizationId,
 		[]string{plan.ProjectID.ValueString()},
 	)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create Secret",
+			sdkErrorDetail(err, s.organizationId, s.verboseErrors, value, s.sensitiveKey(key))+
+				projectScopeDetail(s.bitwardenClient, s.projectCache, s.organizationId, plan.ProjectID.ValueString(), err),
+		)
+		return
+	}
+
+	if err = validateSecretResponse(secret); err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Bitwarden Secrets Manager Response",
 			err.Error(),
 		)
 		return
 	}
 
 	var state secretResourceModel
+	secret.Note, state.RotationIntervalDays = splitRotationInterval(secret.Note)
 	state.ID = types.StringValue(secret.ID)
-	state.Key = types.StringValue(secret.Key)
-	state.Value = types.StringValue(secret.Value)
-	state.Note = types.StringValue(secret.Note)
-	state.ProjectID = types.StringValue(*secret.ProjectID)
-	state.OrganizationID = types.StringValue(secret.OrganizationID)
-	state.CreationDate = types.StringValue(secret.CreationDate.String())
-	state.RevisionDate = types.StringValue(secret.RevisionDate.String())
-	state.AvoidAmbiguous = plan.AvoidAmbiguous
-	state.Length = plan.Length
-	state.Lowercase = plan.Lowercase
-	state.MinLowercase = plan.MinLowercase
-	state.MinNumber = plan.MinNumber
-	state.MinSpecial = plan.MinSpecial
-	state.MinUppercase = plan.MinUppercase
-	state.Numbers = plan.Numbers
-	state.Special = plan.Special
-	state.Uppercase = plan.Uppercase
+	state.Key = stateKey(plan.Key, secret.Key, plan.KeyCase)
+	state.Value = stateValue(secret.Value, plan.TrackValueByHash)
+	resp.Diagnostics.Append(keepConfiguredNote(ctx, plan.Note, secret.Note, &state, resp.Private)...)
+	state.ProjectID, state.OrganizationID, state.CreationDate, state.RevisionDate = secretMetadata(secret)
+	resp.Diagnostics.Append(setContentVersion(ctx, secret.Value, secret.Note, &state, resp.Private)...)
+	state.ValueIsEmpty = types.BoolValue(secret.Value == "")
+	state.ValueLength = valueLength(secret.Value)
+	state.NextRotationAt = nextRotationAt(secret.RevisionDate, state.RotationIntervalDays)
+	copyGeneratorConfig(&plan, &state)
+	if s.refreshTtl > 0 {
+		resp.Diagnostics.Append(setLastRead(ctx, resp.Private)...)
+	}
+	state.AllowWhitespaceKeys = plan.AllowWhitespaceKeys
+	state.KeyCase = plan.KeyCase
+	state.ExpectedValueSha256 = plan.ExpectedValueSha256
+	state.ProjectName = plan.ProjectName
+	state.CreateProjectIfMissing = plan.CreateProjectIfMissing
+	state.ForceNewValueOnImport = plan.ForceNewValueOnImport
+	state.TrackValueByHash = plan.TrackValueByHash
+	state.ValueFromSecretID = plan.ValueFromSecretID
+	state.SourceValueSha256 = sourceValueSha256(plan.ValueFromSecretID, value)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, state)
@@ -330,7 +550,10 @@ func (s *secretResource) Create(ctx context.Context, req resource.CreateRequest,
 }
 
 func (s *secretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	tflog.Info(ctx, "Reading Secret Resource")
+	ctx, correlationId := newCorrelationContext(ctx, s.logLevel)
+	defer appendCorrelationId(&resp.Diagnostics, correlationId)
+
+	tflog.SubsystemInfo(ctx, logSubsystem, "Reading Secret Resource")
 
 	var state secretResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -339,6 +562,8 @@ func (s *secretResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	defer recoverFromPanic(ctx, "Read Secret", state.ID.ValueString(), &resp.Diagnostics)
+
 	if s.bitwardenClient == nil {
 		resp.Diagnostics.AddError(
 			"Client Not Initialized",
@@ -347,22 +572,47 @@ func (s *secretResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	secret, err := s.bitwardenClient.Secrets().Get(state.ID.ValueString())
+	if !checkContext(ctx, "Read Secret", &resp.Diagnostics) {
+		return
+	}
+
+	if s.refreshTtl > 0 {
+		recentlyRead, diags := readWithinTtl(ctx, req.Private, s.refreshTtl)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if recentlyRead {
+			tflog.SubsystemDebug(ctx, logSubsystem, "Skipping refresh of recently read Secret", map[string]any{"id": state.ID.ValueString()})
+			return
+		}
+	}
+
+	secret, err := readSecret(s.bitwardenClient, state.ID.ValueString())
+	if err != nil && s.metadataOnly && isValueReadDenied(err) && s.readMetadata(ctx, &state, &resp.Diagnostics) {
+		resp.Diagnostics.Append(countImportRefresh(ctx, resp.Private)...)
+		diags = resp.State.Set(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to Read Secret with id: "+state.ID.ValueString(),
-			err.Error(),
-		)
+		addReadSecretError(&resp.Diagnostics, state.ID.ValueString(), err, s.organizationId, s.verboseErrors)
 		return
 	}
 
-	state.Key = types.StringValue(secret.Key)
-	state.Value = types.StringValue(secret.Value)
-	state.Note = types.StringValue(secret.Note)
-	state.ProjectID = types.StringValue(*secret.ProjectID)
-	state.OrganizationID = types.StringValue(secret.OrganizationID)
-	state.CreationDate = types.StringValue(secret.CreationDate.String())
-	state.RevisionDate = types.StringValue(secret.RevisionDate.String())
+	secret.Note, state.RotationIntervalDays = splitRotationInterval(secret.Note)
+	state.Key = stateKey(state.Key, secret.Key, state.KeyCase)
+	state.Value = stateValue(secret.Value, state.TrackValueByHash)
+	resp.Diagnostics.Append(readRemoteNote(ctx, secret.Note, &state, resp.Private)...)
+	state.ProjectID, state.OrganizationID, state.CreationDate, state.RevisionDate = secretMetadata(secret)
+	resp.Diagnostics.Append(setContentVersion(ctx, secret.Value, secret.Note, &state, resp.Private)...)
+	state.ValueIsEmpty = types.BoolValue(secret.Value == "")
+	state.ValueLength = valueLength(secret.Value)
+	state.NextRotationAt = nextRotationAt(secret.RevisionDate, state.RotationIntervalDays)
+	if s.refreshTtl > 0 {
+		resp.Diagnostics.Append(setLastRead(ctx, resp.Private)...)
+	}
+	resp.Diagnostics.Append(countImportRefresh(ctx, resp.Private)...)
 
 	// Set state
 	diags = resp.State.Set(ctx, &state)
@@ -373,6 +623,10 @@ func (s *secretResource) Read(ctx context.Context, req resource.ReadRequest, res
 }
 
 func (s *secretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, correlationId := newCorrelationContext(ctx, s.logLevel)
+	defer appendCorrelationId(&resp.Diagnostics, correlationId)
+	defer s.summary.recordIfSucceeded(ctx, "update", &resp.Diagnostics)
+
 	// Retrieve values from plan
 	var plan secretResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -388,6 +642,8 @@ func (s *secretResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	defer recoverFromPanic(ctx, "Update Secret", state.ID.ValueString(), &resp.Diagnostics)
+
 	if s.bitwardenClient == nil {
 		resp.Diagnostics.AddError(
 			"Client Not Initialized",
@@ -396,13 +652,41 @@ func (s *secretResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	if !checkContext(ctx, "Update Secret", &resp.Diagnostics) {
+		return
+	}
+
+	// The Bitwarden SDK only supports updating the whole secret. The secret is re-read right before the update, so that
+	// attributes which are not configured keep their latest value instead of overwriting concurrent changes with the
+	// values from the state.
+	current, err := readSecret(s.bitwardenClient, state.ID.ValueString())
+	if err != nil {
+		addReadSecretError(&resp.Diagnostics, state.ID.ValueString(), err, s.organizationId, s.verboseErrors)
+		return
+	}
+	var currentInterval types.Int64
+	current.Note, currentInterval = splitRotationInterval(current.Note)
+
 	key := plan.Key.ValueString()
 	if key == "" {
-		key = state.Key.ValueString()
+		key = current.Key
 	}
+	key = applyKeyCase(key, plan.KeyCase)
 	value := plan.Value.ValueString()
-	if value == "" {
-		if newGeneratorConfig(&plan, &state) {
+	valueGenerated := false
+	if !plan.ValueFromSecretID.IsNull() {
+		sourceValue, ok := s.readSourceValue(plan.ValueFromSecretID.ValueString(), &resp.Diagnostics)
+		if !ok {
+			return
+		}
+		value = sourceValue
+	} else if value == "" {
+		replaceImported, diags := replaceImportedValue(ctx, plan.ForceNewValueOnImport, req.Private)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if replaceImported || newGeneratorConfig(&plan, &state) {
 			generatedValue, err := createSecretValue(&plan, s.bitwardenClient)
 			if err != nil {
 				resp.Diagnostics.AddError(
@@ -412,52 +696,119 @@ func (s *secretResource) Update(ctx context.Context, req resource.UpdateRequest,
 				return
 			}
 			value = generatedValue
+			valueGenerated = true
 		} else {
-			value = state.Value.ValueString()
+			value = current.Value
 		}
 	}
+	// An unconfigured note keeps the current note, while an empty note clears it.
 	note := plan.Note.ValueString()
-	if note == "" {
-		note = state.Note.ValueString()
+	if plan.Note.IsUnknown() || plan.Note.IsNull() {
+		note = current.Note
 	}
 	projectID := plan.ProjectID.ValueString()
+	// The project is only resolved again if project_name changed, otherwise the plan keeps the project of the state.
+	if plan.ProjectID.IsUnknown() && !plan.ProjectName.IsNull() {
+		resolvedId, ok := s.resolveProjectName(ctx, plan.ProjectName.ValueString(), plan.CreateProjectIfMissing.ValueBool(), &resp.Diagnostics)
+		if !ok {
+			return
+		}
+		projectID = resolvedId
+	}
 	if projectID == "" {
-		projectID = state.ProjectID.ValueString()
+		projectID = *current.ProjectID
+	}
+
+	// Changing the project moves the secret and keeps its ID, value and note. The target project is verified first,
+	// because the error of the Bitwarden Secrets Manager API does not tell whether the project is missing.
+	if projectID != *current.ProjectID && !s.validateTargetProject(projectID, &resp.Diagnostics) {
+		return
+	}
+
+	// The secret is compared with the re-read secret, a note normalized by Bitwarden Secrets Manager in the form in
+	// which it was configured, see keepConfiguredNote.
+	remote := state
+	resp.Diagnostics.Append(readRemoteNote(ctx, current.Note, &remote, resp.Private)...)
+	unchanged := !valueGenerated &&
+		key == current.Key &&
+		value == current.Value &&
+		(note == current.Note || note == remote.Note.ValueString()) &&
+		projectID == *current.ProjectID &&
+		plan.RotationIntervalDays.Equal(currentInterval)
+
+	state.AllowWhitespaceKeys = plan.AllowWhitespaceKeys
+	state.KeyCase = plan.KeyCase
+	state.ExpectedValueSha256 = plan.ExpectedValueSha256
+	state.ProjectName = plan.ProjectName
+	state.CreateProjectIfMissing = plan.CreateProjectIfMissing
+	state.ForceNewValueOnImport = plan.ForceNewValueOnImport
+	state.TrackValueByHash = plan.TrackValueByHash
+	state.ValueFromSecretID = plan.ValueFromSecretID
+	state.SourceValueSha256 = sourceValueSha256(plan.ValueFromSecretID, value)
+
+	// Skip the API call if none of the user-controlled fields changed, e.g. if only computed fields differ. The computed
+	// fields are still refreshed from the re-read secret.
+	if unchanged {
+		tflog.SubsystemDebug(ctx, logSubsystem, "Skipping update of unchanged Secret", map[string]any{"id": state.ID.ValueString()})
+		copyGeneratorConfig(&plan, &state)
+		state.Key = stateKey(plan.Key, key, plan.KeyCase)
+		state.Value = stateValue(value, state.TrackValueByHash)
+		state.Note = remote.Note
+		state.ProjectID, state.OrganizationID, state.CreationDate, state.RevisionDate = secretMetadata(current)
+		state.RotationIntervalDays = currentInterval
+		state.NextRotationAt = nextRotationAt(current.RevisionDate, currentInterval)
+		resp.Diagnostics.Append(setContentVersion(ctx, value, current.Note, &state, resp.Private)...)
+		state.ValueIsEmpty = types.BoolValue(value == "")
+		state.ValueLength = valueLength(value)
+		if s.refreshTtl > 0 {
+			resp.Diagnostics.Append(setLastRead(ctx, resp.Private)...)
+		}
+		resp.Diagnostics.Append(clearImportMarker(ctx, req.Private, resp.Private)...)
+
+		diags = resp.State.Set(ctx, state)
+		resp.Diagnostics.Append(diags...)
+		return
 	}
 
 	secret, err := s.bitwardenClient.Secrets().Update(
 		state.ID.ValueString(),
 		key,
 		value,
-		note,
+		withRotationInterval(note, plan.RotationIntervalDays),
 		state.OrganizationID.ValueString(),
 		[]string{projectID},
 	)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Update Secret",
+			sdkErrorDetail(err, s.organizationId, s.verboseErrors, value, s.sensitiveKey(key))+
+				projectScopeDetail(s.bitwardenClient, s.projectCache, s.organizationId, projectID, err),
+		)
+		return
+	}
+
+	if err = validateSecretResponse(secret); err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Bitwarden Secrets Manager Response",
 			err.Error(),
 		)
 		return
 	}
 
-	state.Key = types.StringValue(secret.Key)
-	state.Value = types.StringValue(secret.Value)
-	state.Note = types.StringValue(secret.Note)
-	state.ProjectID = types.StringValue(*secret.ProjectID)
-	state.OrganizationID = types.StringValue(secret.OrganizationID)
-	state.CreationDate = types.StringValue(secret.CreationDate.String())
-	state.RevisionDate = types.StringValue(secret.RevisionDate.String())
-	state.AvoidAmbiguous = plan.AvoidAmbiguous
-	state.Length = plan.Length
-	state.Lowercase = plan.Lowercase
-	state.MinLowercase = plan.MinLowercase
-	state.MinNumber = plan.MinNumber
-	state.MinSpecial = plan.MinSpecial
-	state.MinUppercase = plan.MinUppercase
-	state.Numbers = plan.Numbers
-	state.Special = plan.Special
-	state.Uppercase = plan.Uppercase
+	secret.Note, state.RotationIntervalDays = splitRotationInterval(secret.Note)
+	state.Key = stateKey(plan.Key, secret.Key, plan.KeyCase)
+	state.Value = stateValue(secret.Value, state.TrackValueByHash)
+	resp.Diagnostics.Append(keepConfiguredNote(ctx, types.StringValue(note), secret.Note, &state, resp.Private)...)
+	state.ProjectID, state.OrganizationID, state.CreationDate, state.RevisionDate = secretMetadata(secret)
+	resp.Diagnostics.Append(setContentVersion(ctx, secret.Value, secret.Note, &state, resp.Private)...)
+	state.ValueIsEmpty = types.BoolValue(secret.Value == "")
+	state.ValueLength = valueLength(secret.Value)
+	state.NextRotationAt = nextRotationAt(secret.RevisionDate, state.RotationIntervalDays)
+	copyGeneratorConfig(&plan, &state)
+	if s.refreshTtl > 0 {
+		resp.Diagnostics.Append(setLastRead(ctx, resp.Private)...)
+	}
+	resp.Diagnostics.Append(clearImportMarker(ctx, req.Private, resp.Private)...)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, state)
@@ -468,6 +819,10 @@ func (s *secretResource) Update(ctx context.Context, req resource.UpdateRequest,
 }
 
 func (s *secretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, correlationId := newCorrelationContext(ctx, s.logLevel)
+	defer appendCorrelationId(&resp.Diagnostics, correlationId)
+	defer s.summary.recordIfSucceeded(ctx, "delete", &resp.Diagnostics)
+
 	var plan secretResourceModel
 	diags := req.State.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -475,6 +830,8 @@ func (s *secretResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	defer recoverFromPanic(ctx, "Delete Secret", plan.ID.ValueString(), &resp.Diagnostics)
+
 	if s.bitwardenClient == nil {
 		resp.Diagnostics.AddError(
 			"Client Not Initialized",
@@ -483,25 +840,621 @@ func (s *secretResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	if !checkContext(ctx, "Delete Secret", &resp.Diagnostics) {
+		return
+	}
+
 	secretDeleteResponse, err := s.bitwardenClient.Secrets().Delete([]string{plan.ID.ValueString()})
+	if err != nil && s.ignoreMissingOnDelete && isNotFoundError(err.Error()) {
+		tflog.SubsystemWarn(ctx, logSubsystem, "Secret not found during deletion, removing it from state", map[string]any{"id": plan.ID.ValueString()})
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Delete Secret",
-			err.Error(),
+			sdkErrorDetail(err, s.organizationId, s.verboseErrors),
+		)
+		return
+	}
+	if secretDeleteResponse == nil || len(secretDeleteResponse.Data) == 0 {
+		resp.Diagnostics.AddError(
+			"Unexpected Bitwarden Secrets Manager Response",
+			"The Bitwarden Secrets Manager API returned an empty response when deleting the secret with id: "+plan.ID.ValueString(),
 		)
 		return
 	}
+	if secretDeleteResponse.Data[0].Error != nil && s.ignoreMissingOnDelete && isNotFoundError(*secretDeleteResponse.Data[0].Error) {
+		tflog.SubsystemWarn(ctx, logSubsystem, "Secret not found during deletion, removing it from state", map[string]any{"id": plan.ID.ValueString()})
+		return
+	}
 	if secretDeleteResponse.Data[0].Error != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting Secret",
-			*secretDeleteResponse.Data[0].Error,
+			sdkErrorDetail(errors.New(*secretDeleteResponse.Data[0].Error), s.organizationId, s.verboseErrors),
+		)
+	}
+}
+
+func (s *secretResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config secretResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.TrackValueByHash.ValueBool() && !config.Value.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("track_value_by_hash"),
+			"Conflicting Secret Value Configuration",
+			"track_value_by_hash can only be enabled for generated values. Remove the value from the configuration to let the provider generate it.",
+		)
+	}
+
+	if !config.ValueFromSecretID.IsNull() && !config.Value.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("value_from_secret_id"),
+			"Conflicting Secret Value Configuration",
+			"value_from_secret_id and value cannot be configured together. Remove one of them from the configuration.",
+		)
+	}
+
+	if !config.ProjectName.IsNull() && !config.ProjectID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("project_name"),
+			"Conflicting Project Configuration",
+			"project_name and project_id cannot be configured together. Remove one of them from the configuration.",
+		)
+	}
+
+	if config.CreateProjectIfMissing.ValueBool() && config.ProjectName.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("create_project_if_missing"),
+			"Missing Project Name",
+			"create_project_if_missing requires project_name to be set.",
+		)
+	}
+
+	// The key can only be validated once it is known.
+	if config.Key.IsUnknown() || config.Key.IsNull() {
+		return
+	}
+
+	if err := validateSecretKey(config.Key.ValueString(), config.AllowWhitespaceKeys.ValueBool()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("key"),
+			"Invalid Secret Key",
+			err.Error(),
 		)
 	}
 }
 
+func (s *secretResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ctx, correlationId := newCorrelationContext(ctx, s.logLevel)
+	defer appendCorrelationId(&resp.Diagnostics, correlationId)
+
+	// Nothing to do on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan secretResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if s.warnValueInState {
+		warnConfiguredValueInState(ctx, req, &resp.Diagnostics)
+	}
+
+	verifyExpectedValue(ctx, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planProjectFromName(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ValueFromSecretID.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("source_value_sha256"), types.StringNull())...)
+	} else {
+		s.planSourceValue(ctx, plan.ValueFromSecretID, req.State, resp)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planContentVersion(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planImportedValue(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	warnRotationOverdue(ctx, plan, &resp.Diagnostics)
+}
+
+// warnConfiguredValueInState warns if a configured value is about to be stored in the Terraform state, which Terraform
+// does even for sensitive values. Unchanged values are not warned about again.
+func warnConfiguredValueInState(ctx context.Context, req resource.ModifyPlanRequest, diags *diag.Diagnostics) {
+	var config, state secretResourceModel
+	diags.Append(req.Config.Get(ctx, &config)...)
+	if !req.State.Raw.IsNull() {
+		diags.Append(req.State.Get(ctx, &state)...)
+	}
+	if diags.HasError() || config.Value.IsNull() || config.Value.Equal(state.Value) {
+		return
+	}
+
+	tflog.SubsystemWarn(ctx, logSubsystem, "Configured secret value will be stored in the Terraform state")
+	diags.AddAttributeWarning(
+		path.Root("value"),
+		"Secret Value Stored in Terraform State",
+		"The configured value of this secret will be stored in plain text in the Terraform state, even if it comes from a sensitive variable. "+
+			"To keep it out of the state, omit value to let the provider generate the value and set track_value_by_hash to store only its hash, "+
+			"or create the secret outside of Terraform and read it with the secret data source where it is needed. "+
+			"Otherwise, protect the Terraform state, e.g. with an encrypted remote backend.\n\n"+
+			"Set warn_value_in_state to false in the provider configuration to suppress this warning.",
+	)
+}
+
+// verifyExpectedValue reports an error if the value of an existing secret, as read by the last refresh, does not match
+// the configured expected_value_sha256. Neither the value nor its hash are included in the error.
+func verifyExpectedValue(ctx context.Context, req resource.ModifyPlanRequest, diags *diag.Diagnostics) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var config, state secretResourceModel
+	diags.Append(req.Config.Get(ctx, &config)...)
+	diags.Append(req.State.Get(ctx, &state)...)
+	if diags.HasError() || config.ExpectedValueSha256.IsNull() || config.ExpectedValueSha256.IsUnknown() {
+		return
+	}
+
+	// The state only contains the hash of the value if track_value_by_hash is enabled.
+	hash := state.Value.ValueString()
+	if !state.TrackValueByHash.ValueBool() {
+		hash = hashSecretValue(hash)
+	}
+	if strings.EqualFold(hash, config.ExpectedValueSha256.ValueString()) {
+		return
+	}
+
+	tflog.SubsystemWarn(ctx, logSubsystem, "Secret value does not match the expected hash", map[string]any{"id": state.ID.ValueString()})
+	diags.AddAttributeError(
+		path.Root("expected_value_sha256"),
+		"Secret Value Does Not Match Expected Hash",
+		fmt.Sprintf("The value of the secret with id: %s in Bitwarden Secrets Manager does not match expected_value_sha256. "+
+			"The secret may have been modified outside of Terraform. Verify the secret in Bitwarden Secrets Manager, "+
+			"then update expected_value_sha256 or remove it from the configuration.", state.ID.ValueString()),
+	)
+}
+
+// planProjectFromName keeps the project_id of the state while the configured project_name is unchanged, so that the
+// project is only resolved by its name again if project_name changes.
+func planProjectFromName(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var config, state secretResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || config.ProjectName.IsNull() {
+		return
+	}
+
+	projectId := types.StringUnknown()
+	if config.ProjectName.Equal(state.ProjectName) {
+		projectId = state.ProjectID
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("project_id"), projectId)...)
+}
+
+// planSourceValue plans the hash of the value of the secret referenced by value_from_secret_id, and plans a new value
+// if the value of the source secret changed.
+func (s *secretResource) planSourceValue(ctx context.Context, valueFromSecretId types.String, priorState tfsdk.State, resp *resource.ModifyPlanResponse) {
+	// The source secret can only be read once its ID is known and the provider is configured.
+	if valueFromSecretId.IsUnknown() || s.bitwardenClient == nil {
+		return
+	}
+
+	defer recoverFromPanic(ctx, "Read Source Secret", valueFromSecretId.ValueString(), &resp.Diagnostics)
+
+	if !checkContext(ctx, "Read Source Secret", &resp.Diagnostics) {
+		return
+	}
+
+	sourceValue, ok := s.readSourceValue(valueFromSecretId.ValueString(), &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	hash := hashSecretValue(sourceValue)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("source_value_sha256"), types.StringValue(hash))...)
+
+	var state secretResourceModel
+	if !priorState.Raw.IsNull() {
+		resp.Diagnostics.Append(priorState.Get(ctx, &state)...)
+	}
+	if state.SourceValueSha256.ValueString() != hash {
+		tflog.SubsystemDebug(ctx, logSubsystem, "Value of source secret changed", map[string]any{"source_id": valueFromSecretId.ValueString()})
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("value"), types.StringUnknown())...)
+	}
+}
+
+// planImportedValue plans to replace the value of a secret imported with force_new_value_on_import, so that the value
+// read from Bitwarden Secrets Manager is not adopted.
+func planImportedValue(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var config, state secretResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	replace, diags := replaceImportedValue(ctx, config.ForceNewValueOnImport, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if !replace || !config.ValueFromSecretID.IsNull() {
+		return
+	}
+
+	if config.Value.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("value"), types.StringUnknown())...)
+	} else if config.Value.Equal(state.Value) {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_version"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("value_is_empty"), types.BoolUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("value_length"), planValueLength(config, state.ValueLength, true))...)
+
+	tflog.SubsystemInfo(ctx, logSubsystem, "Replacing value of imported secret", map[string]any{"id": state.ID.ValueString()})
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("force_new_value_on_import"),
+		"Imported Secret Value Will Be Replaced",
+		fmt.Sprintf("The secret with id: %s was imported with force_new_value_on_import, so its value in Bitwarden Secrets Manager is not adopted. "+
+			"This apply replaces it with the configured value, or with a newly generated value if value is not configured. "+
+			"Consumers of the previous value have to be updated.", state.ID.ValueString()),
+	)
+}
+
+// planContentVersion keeps the content_version of the state unless the value or the note of the secret change, so that
+// lifecycle.replace_triggered_by does not trigger on other changes. Terraform plans unconfigured computed attributes as
+// unknown on every change, although the value is only replaced by the generator or value_from_secret_id and the note is
+// kept.
+func planContentVersion(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() {
+		var config secretResourceModel
+		resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		planValueFacts(ctx, config, secretResourceModel{}, true, resp)
+		return
+	}
+
+	var config, plan, state secretResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	valueChanged := !plan.Value.Equal(state.Value)
+	if plan.Value.IsUnknown() && config.Value.IsNull() {
+		valueChanged = newGeneratorConfig(&plan, &state) || !plan.SourceValueSha256.Equal(state.SourceValueSha256)
+	}
+	noteChanged := !plan.Note.Equal(state.Note)
+	if plan.Note.IsUnknown() && config.Note.IsNull() {
+		noteChanged = false
+	}
+
+	contentVersion := state.ContentVersion
+	if valueChanged || noteChanged {
+		contentVersion = types.StringUnknown()
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_version"), contentVersion)...)
+
+	planValueFacts(ctx, config, state, valueChanged, resp)
+}
+
+// planValueFacts plans the value_is_empty and the value_length of the secret. Both are kept as they are in the state
+// unless the value changes, for the same reason as the content_version.
+func planValueFacts(ctx context.Context, config secretResourceModel, state secretResourceModel, valueChanged bool, resp *resource.ModifyPlanResponse) {
+	valueIsEmpty := state.ValueIsEmpty
+	if valueChanged || valueIsEmpty.IsNull() {
+		valueIsEmpty = types.BoolUnknown()
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("value_is_empty"), valueIsEmpty)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("value_length"), planValueLength(config, state.ValueLength, valueChanged))...)
+}
+
+// planValueLength keeps the value_length of the state unless the value changes. A changed value_length is planned from
+// the configured value and is only unknown if the value is generated, copied or not known until apply.
+func planValueLength(config secretResourceModel, stateLength types.Int64, valueChanged bool) types.Int64 {
+	if !valueChanged && !stateLength.IsNull() {
+		return stateLength
+	}
+	if config.Value.IsNull() || config.Value.IsUnknown() {
+		return types.Int64Unknown()
+	}
+	return valueLength(config.Value.ValueString())
+}
+
 func (s *secretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, correlationId := newCorrelationContext(ctx, s.logLevel)
+	defer appendCorrelationId(&resp.Diagnostics, correlationId)
+
+	if err := uuid.Validate(req.ID); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("The import ID: %s is not a valid secret ID. Secrets can only be imported by their UUID.", req.ID),
+		)
+		return
+	}
+
+	if s.bitwardenClient != nil && !s.validateImportType(ctx, req.ID, &resp.Diagnostics) {
+		return
+	}
+
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	// The configuration is not known during the import, so force_new_value_on_import is evaluated by the next plan.
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedPrivateKey, []byte("0"))...)
+}
+
+// validateTargetProject verifies that the project to which a secret is moved exists and is accessible by the used
+// machine account. Write access cannot be verified upfront, because the Bitwarden SDK does not expose permissions.
+func (s *secretResource) validateTargetProject(projectId string, diags *diag.Diagnostics) bool {
+	project, err := s.projectCache.get(s.bitwardenClient, s.organizationId, projectId)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("project_id"),
+			"Unable to Move Secret",
+			fmt.Sprintf("The secret cannot be moved to the project with id: %s, because the project does not exist or the machine account has no access to it.\n\n%s%s",
+				projectId, sdkErrorDetail(err, s.organizationId, s.verboseErrors), projectScopeDetail(s.bitwardenClient, s.projectCache, s.organizationId, projectId, err)),
+		)
+		return false
+	}
+	return !s.validateProjectOrganization || s.projectInOrganization(project, diags)
+}
+
+// resolveProjectName returns the ID of the project with the given name in the organization of the provider. If no
+// such project exists and create is true, the project is created. The created project is not deleted with the secret.
+func (s *secretResource) resolveProjectName(ctx context.Context, name string, create bool, diags *diag.Diagnostics) (string, bool) {
+	projects, err := s.projectCache.list(s.bitwardenClient, s.organizationId)
+	// A cached listing may miss projects created since, so it is verified before a project is created.
+	if err == nil && s.projectCache != nil && create && len(projectIdsByName(projects, name)) == 0 {
+		projects, err = listProjects(s.bitwardenClient, s.organizationId)
+	}
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("project_name"),
+			"Unable to Read Projects",
+			fmt.Sprintf("Unable to read the projects to resolve the project named: %s.\n\n%s", name, sdkErrorDetail(err, s.organizationId, s.verboseErrors)),
+		)
+		return "", false
+	}
+
+	matches := projectIdsByName(projects, name)
+
+	switch {
+	case len(matches) == 1:
+		return matches[0], true
+	case len(matches) > 1:
+		diags.AddAttributeError(
+			path.Root("project_name"),
+			"Ambiguous Project Name",
+			fmt.Sprintf("The machine account can read %d projects named: %s. Configure project_id with one of the IDs: %s.", len(matches), name, strings.Join(matches, ", ")),
+		)
+		return "", false
+	case !create:
+		diags.AddAttributeError(
+			path.Root("project_name"),
+			"Project Not Found",
+			fmt.Sprintf("No project named: %s exists or the machine account has no access to it. "+
+				"Set create_project_if_missing to true to create the project.", name),
+		)
+		return "", false
+	}
+
+	tflog.SubsystemInfo(ctx, logSubsystem, "Creating missing project", map[string]any{"name": name})
+	project, err := s.bitwardenClient.Projects().Create(s.organizationId, name)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("project_name"),
+			"Unable to Create Project",
+			fmt.Sprintf("Unable to create the project named: %s.\n\n%s", name, sdkErrorDetail(err, s.organizationId, s.verboseErrors)),
+		)
+		return "", false
+	}
+	if project == nil || project.ID == "" {
+		diags.AddAttributeError(
+			path.Root("project_name"),
+			"Unexpected Bitwarden Secrets Manager Response",
+			fmt.Sprintf("The creation of the project named: %s returned no project ID.", name),
+		)
+		return "", false
+	}
+	s.projectCache.add(*project)
+	return project.ID, true
+}
+
+// projectIdsByName returns the IDs of the projects with the given name.
+func projectIdsByName(projects []sdk.ProjectResponse, name string) []string {
+	var ids []string
+	for _, project := range projects {
+		if project.Name == name {
+			ids = append(ids, project.ID)
+		}
+	}
+	return ids
+}
+
+// verifyProjectOrganization verifies that the project of a new secret belongs to the organization of the provider.
+func (s *secretResource) verifyProjectOrganization(projectId string, diags *diag.Diagnostics) bool {
+	project, err := s.projectCache.get(s.bitwardenClient, s.organizationId, projectId)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("project_id"),
+			"Unable to Read Project",
+			fmt.Sprintf("Unable to read the project with id: %s to verify its organization. The project does not exist or the machine account has no access to it.\n\n%s%s",
+				projectId, sdkErrorDetail(err, s.organizationId, s.verboseErrors), projectScopeDetail(s.bitwardenClient, s.projectCache, s.organizationId, projectId, err)),
+		)
+		return false
+	}
+	return s.projectInOrganization(project, diags)
+}
+
+// projectInOrganization reports an error if the project does not belong to the organization of the provider, which the
+// Bitwarden Secrets Manager API only reports with an unclear error.
+func (s *secretResource) projectInOrganization(project *sdk.ProjectResponse, diags *diag.Diagnostics) bool {
+	if project == nil || project.OrganizationID == s.organizationId {
+		return true
+	}
+	diags.AddAttributeError(
+		path.Root("project_id"),
+		"Project Belongs to Another Organization",
+		fmt.Sprintf("The project with id: %s belongs to a different organization than the organization_id configured on the provider, "+
+			"in which the secret is managed. Use a project of the configured organization or configure a provider for the organization of the project.", project.ID),
+	)
+	return false
+}
+
+// isValueReadDenied reports whether an error of readSecret may be caused by a machine account which can list a secret
+// but not read its value. The Bitwarden Secrets Manager API reports missing permissions like missing secrets.
+func isValueReadDenied(err error) bool {
+	return isNotFoundError(err.Error()) || isAccessDeniedError(err.Error())
+}
+
+// readMetadata refreshes the key, project and organization of a secret whose value cannot be read from the listed
+// secrets of the organization, and keeps the remaining attributes of the state. It returns false if the secret is not
+// listed either, in which case the error of the original read applies.
+func (s *secretResource) readMetadata(ctx context.Context, state *secretResourceModel, diags *diag.Diagnostics) bool {
+	identifier, err := s.findSecretIdentifier(state.ID.ValueString())
+	if err != nil || identifier == nil {
+		return false
+	}
+
+	tflog.SubsystemWarn(ctx, logSubsystem, "Secret value not readable, refreshed metadata only", map[string]any{"id": identifier.ID})
+	state.Key = stateKey(state.Key, identifier.Key, state.KeyCase)
+	state.OrganizationID = types.StringValue(identifier.OrganizationID)
+	if len(identifier.ProjectIDS) > 0 {
+		state.ProjectID = types.StringValue(identifier.ProjectIDS[0])
+	}
+	diags.AddWarning(
+		"Secret Value Not Readable",
+		fmt.Sprintf("The machine account can list the secret with id: %s but cannot read its value. Because metadata_only is enabled on the provider, "+
+			"only its key, project and organization were read, while its value and note are kept as they are stored in the Terraform state. "+
+			"Updating the secret requires a machine account which can read its value.", identifier.ID),
+	)
+	return true
+}
+
+// findSecretIdentifier returns the identifier of the secret with the given ID among the secrets listed in the
+// organization of the provider, or nil if the secret is not listed. Listing does not return the values of secrets.
+func (s *secretResource) findSecretIdentifier(secretId string) (*sdk.SecretIdentifierResponse, error) {
+	identifiers, err := s.bitwardenClient.Secrets().List(s.organizationId)
+	if err != nil || identifiers == nil {
+		return nil, err
+	}
+	for _, identifier := range identifiers.Data {
+		if identifier.ID == secretId {
+			return &identifier, nil
+		}
+	}
+	return nil, nil
+}
+
+// readSourceValue reads the value of the secret referenced by value_from_secret_id.
+func (s *secretResource) readSourceValue(sourceId string, diags *diag.Diagnostics) (string, bool) {
+	source, err := s.bitwardenClient.Secrets().Get(sourceId)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("value_from_secret_id"),
+			"Unable to Read Source Secret",
+			fmt.Sprintf("Unable to read the secret with id: %s referenced by value_from_secret_id. "+
+				"The used machine account requires read access to it.\n\n%s", sourceId, sdkErrorDetail(err, s.organizationId, s.verboseErrors)),
+		)
+		return "", false
+	}
+
+	if source == nil {
+		diags.AddError(
+			"Unexpected Bitwarden Secrets Manager Response",
+			"The Bitwarden Secrets Manager API returned an empty response when reading the secret with id: "+sourceId,
+		)
+		return "", false
+	}
+
+	return source.Value, true
+}
+
+// sensitiveKey returns the given secret key if it must be redacted from raw errors of the Bitwarden SDK because
+// redact_keys is enabled, otherwise an empty string, which is never redacted.
+func (s *secretResource) sensitiveKey(key string) string {
+	if !s.redactKeys {
+		return ""
+	}
+	return key
+}
+
+// validateImportType verifies that the imported ID belongs to a secret of the organization of the provider. Secrets
+// and projects both use bare UUIDs, so a project ID is detected and reported with a hint instead of failing cryptically
+// during the following read.
+func (s *secretResource) validateImportType(ctx context.Context, id string, diags *diag.Diagnostics) bool {
+	defer recoverFromPanic(ctx, "Import Secret", id, diags)
+
+	secret, err := s.bitwardenClient.Secrets().Get(id)
+	if err == nil && secret != nil && secret.OrganizationID != s.organizationId {
+		diags.AddError(
+			"Imported Secret Belongs to Another Organization",
+			fmt.Sprintf("The secret with id: %s belongs to the organization with id: %s, but the provider manages secrets in the organization with id: %s. "+
+				"Import the secret with a provider configured for its organization.", id, secret.OrganizationID, s.organizationId),
+		)
+		return false
+	}
+	if err == nil || !isNotFoundError(err.Error()) {
+		// Other errors are reported by the read following the import.
+		return true
+	}
+
+	// The read following the import falls back to the metadata of the secret if its value cannot be read.
+	if s.metadataOnly {
+		if identifier, listErr := s.findSecretIdentifier(id); listErr == nil && identifier != nil {
+			return true
+		}
+	}
+
+	if _, projectErr := s.bitwardenClient.Projects().Get(id); projectErr == nil {
+		diags.AddError(
+			"Imported ID Belongs to a Project",
+			fmt.Sprintf("The ID: %s belongs to a project and not to a secret, so it cannot be imported as a bitwarden-secrets_secret resource. "+
+				"Projects are not managed as resources by this provider; use the projects data source to read them.", id),
+		)
+		return false
+	}
+
+	diags.AddError(
+		"Cannot Import Non-Existent Secret",
+		fmt.Sprintf("No secret with the ID: %s exists or the machine account has no access to it.\n\n%s", id, sdkErrorDetail(err, s.organizationId, s.verboseErrors)),
+	)
+	return false
 }
 
 func createSecretValue(config *secretResourceModel, bitwardenClient sdk.BitwardenClientInterface) (string, error) {
@@ -531,6 +1484,242 @@ func createSecretValue(config *secretResourceModel, bitwardenClient sdk.Bitwarde
 	return *password, nil
 }
 
+// privateState provides access to the private state of a resource.
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// remoteNotePrivateKey is the private state key of the note as stored by Bitwarden Secrets Manager, if it differs from the configured note.
+const remoteNotePrivateKey = "remote_note"
+
+// importedPrivateKey is the private state key which marks a secret as imported until its first update. Its value is
+// the number of refreshes since the import, so that the mark also expires if the value is never replaced.
+const importedPrivateKey = "imported"
+
+// maxImportRefreshes is the number of refreshes after which the import mark expires. The first refresh is the read of
+// the import itself, the second one the refresh of the plan following terraform import, which still has to see the
+// mark.
+const maxImportRefreshes = 2
+
+// countImportRefresh counts a refresh of an imported secret and clears the import mark once it expired, so that
+// setting force_new_value_on_import long after the import does not replace the value. Marks which are not a number
+// were stored by earlier versions, which never expired them, and are cleared as well.
+func countImportRefresh(ctx context.Context, private privateState) diag.Diagnostics {
+	imported, diags := private.GetKey(ctx, importedPrivateKey)
+	if imported == nil || diags.HasError() {
+		return diags
+	}
+	refreshes, err := strconv.Atoi(string(imported))
+	if err != nil || refreshes >= maxImportRefreshes {
+		tflog.SubsystemDebug(ctx, logSubsystem, "Clearing expired import mark")
+		return private.SetKey(ctx, importedPrivateKey, nil)
+	}
+	return private.SetKey(ctx, importedPrivateKey, []byte(strconv.Itoa(refreshes+1)))
+}
+
+// clearImportMarker clears the import mark after an update, whether or not the update replaced the value.
+func clearImportMarker(ctx context.Context, prior privateState, private privateState) diag.Diagnostics {
+	if imported, _ := prior.GetKey(ctx, importedPrivateKey); imported == nil {
+		return nil
+	}
+	return private.SetKey(ctx, importedPrivateKey, nil)
+}
+
+// replaceImportedValue returns whether the value of a secret has to be replaced because it was imported with
+// force_new_value_on_import and has not been updated since.
+func replaceImportedValue(ctx context.Context, forceNewValueOnImport types.Bool, private privateState) (bool, diag.Diagnostics) {
+	if !forceNewValueOnImport.ValueBool() {
+		return false, nil
+	}
+	imported, diags := private.GetKey(ctx, importedPrivateKey)
+	return imported != nil, diags
+}
+
+// lastReadPrivateKey is the private state key of the time at which the secret was last read from Bitwarden Secrets
+// Manager. It is only stored if refresh_ttl_seconds is set.
+const lastReadPrivateKey = "last_read"
+
+// setLastRead stores the current time as the time at which the secret was last read.
+func setLastRead(ctx context.Context, private privateState) diag.Diagnostics {
+	lastRead, err := json.Marshal(time.Now().UTC())
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Unable to Store Read Time", err.Error())
+		return diags
+	}
+	return private.SetKey(ctx, lastReadPrivateKey, lastRead)
+}
+
+// readWithinTtl returns whether the secret was read less than ttl ago. Secrets without a valid read time are always
+// read again.
+func readWithinTtl(ctx context.Context, private privateState, ttl time.Duration) (bool, diag.Diagnostics) {
+	stored, diags := private.GetKey(ctx, lastReadPrivateKey)
+	if diags.HasError() || stored == nil {
+		return false, diags
+	}
+
+	var lastRead time.Time
+	if err := json.Unmarshal(stored, &lastRead); err != nil {
+		return false, diags
+	}
+	return time.Since(lastRead) < ttl, diags
+}
+
+// keepConfiguredNote sets the note in the state after a create or update. If Bitwarden Secrets Manager stored the note
+// differently than configured, the configured note is kept byte-for-byte and the stored note is remembered in the
+// private state, so that readRemoteNote does not report the normalization as a change.
+func keepConfiguredNote(ctx context.Context, configured types.String, remote string, state *secretResourceModel, private privateState) diag.Diagnostics {
+	if configured.IsUnknown() || configured.IsNull() || configured.ValueString() == remote {
+		state.Note = types.StringValue(remote)
+		return nil
+	}
+
+	var diags diag.Diagnostics
+	diags.AddWarning(
+		"Note Normalized by Bitwarden Secrets Manager",
+		fmt.Sprintf("Bitwarden Secrets Manager stored the note of the secret with id: %s differently than configured. "+
+			"The configured note is kept in the Terraform state. "+
+			"Changes of the note outside of Terraform will be shown as planned changes.", state.ID.ValueString()),
+	)
+
+	state.Note = configured
+	remoteNote, err := json.Marshal(remote)
+	if err != nil {
+		diags.AddError("Unable to Store Note", err.Error())
+		return diags
+	}
+	diags.Append(private.SetKey(ctx, remoteNotePrivateKey, remoteNote)...)
+	return diags
+}
+
+// readRemoteNote sets the note read from Bitwarden Secrets Manager in the state, unless it is the normalized form of
+// the note kept by keepConfiguredNote.
+func readRemoteNote(ctx context.Context, remote string, state *secretResourceModel, private privateState) diag.Diagnostics {
+	storedNote, diags := private.GetKey(ctx, remoteNotePrivateKey)
+	if diags.HasError() {
+		return diags
+	}
+
+	if storedNote == nil {
+		state.Note = types.StringValue(remote)
+		return diags
+	}
+
+	remoteNote, err := json.Marshal(remote)
+	if err != nil {
+		diags.AddError("Unable to Read Note", err.Error())
+		return diags
+	}
+
+	if bytes.Equal(storedNote, remoteNote) {
+		return diags
+	}
+
+	// The note was changed outside of Terraform.
+	state.Note = types.StringValue(remote)
+	diags.Append(private.SetKey(ctx, remoteNotePrivateKey, nil)...)
+	return diags
+}
+
+// applyKeyCase returns the key as it is sent to Bitwarden Secrets Manager with the given key_case.
+func applyKeyCase(key string, keyCase types.String) string {
+	switch keyCase.ValueString() {
+	case "upper":
+		return strings.ToUpper(key)
+	case "lower":
+		return strings.ToLower(key)
+	default:
+		return key
+	}
+}
+
+// stateKey returns the key of the secret in the Terraform state. The configured key is kept if Bitwarden Secrets
+// Manager stores its normalized form, so that key_case does not cause perpetual differences.
+func stateKey(configured types.String, remote string, keyCase types.String) types.String {
+	if !configured.IsNull() && !configured.IsUnknown() && applyKeyCase(configured.ValueString(), keyCase) == remote {
+		return configured
+	}
+	return types.StringValue(remote)
+}
+
+// contentVersionSaltPrivateKey is the private state key of the random salt of the content_version of a secret.
+const contentVersionSaltPrivateKey = "content_version_salt"
+
+// setContentVersion sets the content_version of the secret in the state. It is keyed with a random salt of the
+// resource, so that the content_version shown in plans cannot be used to recover weak values by brute force. Secrets
+// without a salt, e.g. those created by earlier versions, get a new salt, which changes their content_version once.
+func setContentVersion(ctx context.Context, value string, note string, state *secretResourceModel, private privateState) diag.Diagnostics {
+	stored, diags := private.GetKey(ctx, contentVersionSaltPrivateKey)
+	if diags.HasError() {
+		return diags
+	}
+
+	var salt string
+	if stored == nil || json.Unmarshal(stored, &salt) != nil || salt == "" {
+		random := make([]byte, 32)
+		if _, err := rand.Read(random); err != nil {
+			diags.AddError("Unable to Generate Content Version Salt", err.Error())
+			return diags
+		}
+		salt = hex.EncodeToString(random)
+		encoded, err := json.Marshal(salt)
+		if err != nil {
+			diags.AddError("Unable to Store Content Version Salt", err.Error())
+			return diags
+		}
+		diags.Append(private.SetKey(ctx, contentVersionSaltPrivateKey, encoded)...)
+	}
+
+	state.ContentVersion = contentVersion(salt, value, note)
+	return diags
+}
+
+// contentVersion returns the HMAC of the value and the note of a secret keyed with the given salt. The value is
+// prefixed with its length, so that different combinations of value and note never result in the same content.
+func contentVersion(salt string, value string, note string) types.String {
+	mac := hmac.New(sha256.New, []byte(salt))
+	_, _ = fmt.Fprintf(mac, "%d:%s%s", len(value), value, note)
+	return types.StringValue(hex.EncodeToString(mac.Sum(nil)))
+}
+
+// valueLength returns the number of characters of a secret value.
+func valueLength(value string) types.Int64 {
+	return types.Int64Value(int64(utf8.RuneCountInString(value)))
+}
+
+// stateValue returns the representation of a secret value in the Terraform state, which is the SHA-256 hash of the
+// value if trackByHash is true.
+func stateValue(value string, trackByHash types.Bool) types.String {
+	if trackByHash.ValueBool() {
+		return types.StringValue(hashSecretValue(value))
+	}
+	return types.StringValue(value)
+}
+
+// sourceValueSha256 returns the hash of the value copied from the secret referenced by valueFromSecretId, or null if
+// the value is not copied from another secret.
+func sourceValueSha256(valueFromSecretId types.String, value string) types.String {
+	if valueFromSecretId.IsNull() {
+		return types.StringNull()
+	}
+	return types.StringValue(hashSecretValue(value))
+}
+
+// copyGeneratorConfig copies the secret generator configuration from the plan into the state.
+func copyGeneratorConfig(plan *secretResourceModel, state *secretResourceModel) {
+	state.AvoidAmbiguous = plan.AvoidAmbiguous
+	state.Length = plan.Length
+	state.Lowercase = plan.Lowercase
+	state.MinLowercase = plan.MinLowercase
+	state.MinNumber = plan.MinNumber
+	state.MinSpecial = plan.MinSpecial
+	state.MinUppercase = plan.MinUppercase
+	state.Numbers = plan.Numbers
+	state.Special = plan.Special
+	state.Uppercase = plan.Uppercase
+}
+
 func newGeneratorConfig(plan *secretResourceModel, state *secretResourceModel) bool {
 	// Compare all relevant generator configuration attributes between plan and state
 	return plan.AvoidAmbiguous.ValueBool() != state.AvoidAmbiguous.ValueBool() ||
@@ -1,13 +1,21 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"github.com/bitwarden/sdk-go/v2"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 	"unicode"
 )
 
@@ -74,6 +82,50 @@ func TestAccResourceSecretCreateSecretWithExplicitValue(t *testing.T) {
 	})
 }
 
+func TestAccResourceSecretCreateSecretReplayable(t *testing.T) {
+	secretKey := "Test-Secret-Replay"
+	secretValue := "replay-value"
+	secretNote := "replay-note"
+	projectName := "Test-Project-Replay-Secret"
+
+	bitwardenClient, organizationId, providerConfig := newAccTestClient(t, "secret_create")
+
+	project, preCheckError := bitwardenClient.Projects().Create(organizationId, projectName)
+	if preCheckError != nil {
+		t.Fatal("Error creating test project for provider validation.")
+	}
+
+	config := SecretResourceConfig{}
+	config.key = types.StringValue(secretKey)
+	config.value = types.StringValue(secretValue)
+	config.note = types.StringValue(secretNote)
+	config.projectId = types.StringValue(project.ID)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig +
+					buildSecretResourceConfig(config),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bitwarden-secrets_secret.test", "key", secretKey),
+					resource.TestCheckResourceAttr("bitwarden-secrets_secret.test", "value", secretValue),
+					resource.TestCheckResourceAttr("bitwarden-secrets_secret.test", "organization_id", organizationId),
+					resource.TestCheckResourceAttr("bitwarden-secrets_secret.test", "note", secretNote),
+					resource.TestCheckResourceAttr("bitwarden-secrets_secret.test", "project_id", project.ID),
+				),
+			},
+		},
+		CheckDestroy: func(state *terraform.State) error {
+			_, cleanUpErr := bitwardenClient.Projects().Delete([]string{project.ID})
+			if cleanUpErr != nil {
+				t.Fatalf("Error cleaning up test project: %s", cleanUpErr.Error())
+			}
+			return nil
+		},
+	})
+}
+
 func TestAccResourceSecretCreateSecretWithDefaultGeneratorConfig(t *testing.T) {
 	secretKey := "Test-Secret-" + generateRandomString()
 	secretNote := generateRandomString()
@@ -644,3 +696,1639 @@ func TestAccResourceSecretDynamicSecret(t *testing.T) {
 		},
 	})
 }
+
+func TestSecretResourceCreateWithEmptyResponse(t *testing.T) {
+	client := newMockBitwardenClient()
+	client.secretCreateHook = func(_, _, _ string, _ string, _ []string) (*sdk.SecretResponse, error) {
+		return nil, nil
+	}
+	r := &secretResource{bitwardenClient: client, organizationId: mockOrgId}
+	schema := secretResourceTestSchema(t)
+
+	req := fwresource.CreateRequest{Plan: newTestPlan(t, schema, secretResourceModel{
+		ID:        types.StringUnknown(),
+		Key:       types.StringValue("key"),
+		Value:     types.StringValue("value"),
+		ProjectID: types.StringValue(validProjectUUID),
+	})}
+	resp := fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	r.Create(context.Background(), req, &resp)
+
+	if !diagnosticsContain(resp.Diagnostics, "Unexpected Bitwarden Secrets Manager Response") {
+		t.Fatalf("expected diagnostic about unexpected response, got: %v", resp.Diagnostics)
+	}
+}
+
+func TestSecretResourceReadWithMissingProjectId(t *testing.T) {
+	client := newMockBitwardenClient()
+	secret := client.addSecret("key", "value", "", mockOrgId, validProjectUUID)
+	client.secretGetHook = func(secretID string) (*sdk.SecretResponse, error) {
+		partial := secret
+		partial.ProjectID = nil
+		return &partial, nil
+	}
+	r := &secretResource{bitwardenClient: client, organizationId: mockOrgId}
+	schema := secretResourceTestSchema(t)
+
+	state := newTestState(t, schema, secretResourceModel{ID: types.StringValue(secret.ID)})
+	resp := fwresource.ReadResponse{State: state}
+	r.Read(context.Background(), fwresource.ReadRequest{State: state}, &resp)
+
+	if !diagnosticsContain(resp.Diagnostics, "without a project ID") {
+		t.Fatalf("expected diagnostic about missing project ID, got: %v", resp.Diagnostics)
+	}
+}
+
+func TestSecretResourceReadRecoversFromPanic(t *testing.T) {
+	client := newMockBitwardenClient()
+	client.secretGetHook = func(secretID string) (*sdk.SecretResponse, error) {
+		panic("malformed response")
+	}
+	r := &secretResource{bitwardenClient: client, organizationId: mockOrgId}
+	schema := secretResourceTestSchema(t)
+
+	state := newTestState(t, schema, secretResourceModel{ID: types.StringValue(validProjectUUID)})
+	resp := fwresource.ReadResponse{State: state}
+	r.Read(context.Background(), fwresource.ReadRequest{State: state}, &resp)
+
+	if !diagnosticsContain(resp.Diagnostics, "Read Secret") || !diagnosticsContain(resp.Diagnostics, validProjectUUID) {
+		t.Fatalf("expected recovered diagnostic with operation and id, got: %v", resp.Diagnostics)
+	}
+}
+
+func TestSecretResourceDeleteWithEmptyResponse(t *testing.T) {
+	client := newMockBitwardenClient()
+	client.secretDeleteHook = func(secretIDs []string) (*sdk.SecretsDeleteResponse, error) {
+		return &sdk.SecretsDeleteResponse{}, nil
+	}
+	r := &secretResource{bitwardenClient: client, organizationId: mockOrgId}
+	schema := secretResourceTestSchema(t)
+
+	state := newTestState(t, schema, secretResourceModel{ID: types.StringValue(validProjectUUID)})
+	resp := fwresource.DeleteResponse{State: state}
+	r.Delete(context.Background(), fwresource.DeleteRequest{State: state}, &resp)
+
+	if !diagnosticsContain(resp.Diagnostics, "empty response") {
+		t.Fatalf("expected diagnostic about empty delete response, got: %v", resp.Diagnostics)
+	}
+}
+
+func TestSecretResourceDeleteMissingSecret(t *testing.T) {
+	schema := secretResourceTestSchema(t)
+	state := newTestState(t, schema, secretResourceModel{ID: types.StringValue(validProjectUUID)})
+
+	r := &secretResource{bitwardenClient: newMockBitwardenClient(), organizationId: mockOrgId}
+	resp := fwresource.DeleteResponse{State: state}
+	r.Delete(context.Background(), fwresource.DeleteRequest{State: state}, &resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error when deleting a missing secret without ignore_missing_on_delete")
+	}
+
+	r.ignoreMissingOnDelete = true
+	resp = fwresource.DeleteResponse{State: state}
+	r.Delete(context.Background(), fwresource.DeleteRequest{State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("expected no error when deleting a missing secret with ignore_missing_on_delete, got: %v", resp.Diagnostics)
+	}
+}
+
+func TestSecretResourceUpdateSkipsUnchangedSecret(t *testing.T) {
+	tests := map[string]struct {
+		key             string
+		expectedUpdates int
+	}{
+		"only computed fields differ": {key: "key", expectedUpdates: 0},
+		"key changed":                 {key: "new-key", expectedUpdates: 1},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := newMockBitwardenClient()
+			secret := client.addSecret("key", "value", "note", mockOrgId, validProjectUUID)
+			r := &secretResource{bitwardenClient: client, organizationId: mockOrgId}
+			schema := secretResourceTestSchema(t)
+
+			stateModel := secretResourceModel{
+				ID:             types.StringValue(secret.ID),
+				Key:            types.StringValue(secret.Key),
+				Value:          types.StringValue(secret.Value),
+				Note:           types.StringValue(secret.Note),
+				ProjectID:      types.StringValue(*secret.ProjectID),
+				OrganizationID: types.StringValue(secret.OrganizationID),
+				CreationDate:   types.StringValue(secret.CreationDate.String()),
+				RevisionDate:   types.StringValue(secret.RevisionDate.String()),
+			}
+			planModel := stateModel
+			planModel.Key = types.StringValue(test.key)
+			planModel.Value = types.StringUnknown()
+			planModel.RevisionDate = types.StringUnknown()
+
+			// The secret was revised since the last refresh without changing its content.
+			secret.RevisionDate = secret.RevisionDate.Add(time.Hour)
+			client.secrets[secret.ID] = secret
+
+			state := newTestState(t, schema, stateModel)
+			req := fwresource.UpdateRequest{State: state, Plan: newTestPlan(t, schema, planModel)}
+			resp := fwresource.UpdateResponse{State: state}
+			newTestPrivateState(&resp.Private)
+			r.Update(context.Background(), req, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			if updates := client.callCount("Secrets.Update"); updates != test.expectedUpdates {
+				t.Fatalf("expected %d calls to Secrets.Update, got: %d", test.expectedUpdates, updates)
+			}
+
+			var newState secretResourceModel
+			resp.State.Get(context.Background(), &newState)
+			if newState.Key.ValueString() != test.key || newState.RevisionDate.IsUnknown() {
+				t.Fatalf("unexpected state after update: %+v", newState)
+			}
+			if test.expectedUpdates == 0 && !newState.RevisionDate.Equal(timestampValue(secret.RevisionDate)) {
+				t.Fatalf("expected the revision date %s of the server, got: %s", timestampValue(secret.RevisionDate), newState.RevisionDate)
+			}
+		})
+	}
+}
+
+func TestSecretResourceValidateConfigKey(t *testing.T) {
+	tests := map[string]struct {
+		key                 types.String
+		allowWhitespaceKeys types.Bool
+		expectError         bool
+	}{
+		"valid key":                       {key: types.StringValue("DATABASE_URL")},
+		"unknown key":                     {key: types.StringUnknown()},
+		"trailing newline":                {key: types.StringValue("DATABASE_URL\n"), expectError: true},
+		"embedded tab":                    {key: types.StringValue("DATABASE\tURL"), expectError: true},
+		"leading space":                   {key: types.StringValue(" DATABASE_URL"), expectError: true},
+		"leading space allowed":           {key: types.StringValue(" DATABASE_URL"), allowWhitespaceKeys: types.BoolValue(true)},
+		"trailing newline always invalid": {key: types.StringValue("DATABASE_URL\n"), allowWhitespaceKeys: types.BoolValue(true), expectError: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := &secretResource{}
+			schema := secretResourceTestSchema(t)
+			plan := newTestPlan(t, schema, secretResourceModel{Key: test.key, AllowWhitespaceKeys: test.allowWhitespaceKeys})
+
+			resp := fwresource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), fwresource.ValidateConfigRequest{Config: tfsdk.Config{Schema: schema, Raw: plan.Raw}}, &resp)
+
+			if resp.Diagnostics.HasError() != test.expectError {
+				t.Fatalf("expected error to be %t, got: %v", test.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestSecretResourceValidateConfigRedactsKey(t *testing.T) {
+	// ValidateConfig runs before the provider is configured, so redact_keys is not known yet.
+	r := &secretResource{}
+	schema := secretResourceTestSchema(t)
+	plan := newTestPlan(t, schema, secretResourceModel{Key: types.StringValue("DATABASE_PASSWORD\n")})
+
+	resp := fwresource.ValidateConfigResponse{}
+	r.ValidateConfig(context.Background(), fwresource.ValidateConfigRequest{Config: tfsdk.Config{Schema: schema, Raw: plan.Raw}}, &resp)
+
+	if !diagnosticsContain(resp.Diagnostics, "Invalid Secret Key") {
+		t.Fatalf("expected an invalid key error, got: %v", resp.Diagnostics)
+	}
+	if diagnosticsContain(resp.Diagnostics, "DATABASE_PASSWORD") {
+		t.Errorf("expected the key to be redacted, got: %v", resp.Diagnostics)
+	}
+}
+
+func TestSecretResourceImportStateValidatesId(t *testing.T) {
+	tests := map[string]struct {
+		id          string
+		expectError bool
+	}{
+		"valid id":   {id: validProjectUUID},
+		"invalid id": {id: "my-secret", expectError: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := &secretResource{}
+			schema := secretResourceTestSchema(t)
+
+			resp := fwresource.ImportStateResponse{State: newTestState(t, schema, secretResourceModel{})}
+			newTestPrivateState(&resp.Private)
+			r.ImportState(context.Background(), fwresource.ImportStateRequest{ID: test.id}, &resp)
+
+			if resp.Diagnostics.HasError() != test.expectError {
+				t.Fatalf("expected error to be %t, got: %v", test.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestSecretResourceNoteRoundTrip(t *testing.T) {
+	note := "# On-call\n\n- restart the `api` service  \n\t* then check the logs\r\n\n"
+	normalize := func(note string) string { return strings.TrimSpace(note) }
+
+	tests := map[string]struct {
+		normalize func(string) string
+		warning   bool
+	}{
+		"stored byte-for-byte": {normalize: func(note string) string { return note }},
+		"normalized by api":    {normalize: normalize, warning: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := newMockBitwardenClient()
+			client.secretCreateHook = func(key, value, note string, organizationID string, projectIDs []string) (*sdk.SecretResponse, error) {
+				secret := client.addSecret(key, value, test.normalize(note), organizationID, projectIDs[0])
+				return &secret, nil
+			}
+			r := &secretResource{bitwardenClient: client, organizationId: mockOrgId}
+			schema := secretResourceTestSchema(t)
+
+			createReq := fwresource.CreateRequest{Plan: newTestPlan(t, schema, secretResourceModel{
+				ID:        types.StringUnknown(),
+				Key:       types.StringValue("key"),
+				Value:     types.StringValue("value"),
+				Note:      types.StringValue(note),
+				ProjectID: types.StringValue(validProjectUUID),
+			})}
+			createResp := fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+			newTestPrivateState(&createResp.Private)
+			r.Create(context.Background(), createReq, &createResp)
+			if createResp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", createResp.Diagnostics)
+			}
+			if (createResp.Diagnostics.WarningsCount() > 0) != test.warning {
+				t.Errorf("expected warning to be %t, got: %v", test.warning, createResp.Diagnostics)
+			}
+
+			readResp := fwresource.ReadResponse{State: createResp.State, Private: createResp.Private}
+			r.Read(context.Background(), fwresource.ReadRequest{State: createResp.State, Private: createResp.Private}, &readResp)
+			if readResp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", readResp.Diagnostics)
+			}
+
+			var state secretResourceModel
+			readResp.State.Get(context.Background(), &state)
+			if state.Note.ValueString() != note {
+				t.Fatalf("expected note %q, got: %q", note, state.Note.ValueString())
+			}
+		})
+	}
+}
+
+func TestSecretResourceNullAndEmptyNote(t *testing.T) {
+	tests := map[string]struct {
+		remoteNote   string
+		plannedNote  types.String
+		expectedNote string
+	}{
+		"unconfigured note keeps note":       {remoteNote: "note", plannedNote: types.StringUnknown(), expectedNote: "note"},
+		"unconfigured note keeps empty note": {remoteNote: "", plannedNote: types.StringUnknown(), expectedNote: ""},
+		"empty note clears note":             {remoteNote: "note", plannedNote: types.StringValue(""), expectedNote: ""},
+		"empty note keeps empty note":        {remoteNote: "", plannedNote: types.StringValue(""), expectedNote: ""},
+		"populated note sets note":           {remoteNote: "", plannedNote: types.StringValue("new note"), expectedNote: "new note"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := newMockBitwardenClient()
+			secret := client.addSecret("key", "value", test.remoteNote, mockOrgId, validProjectUUID)
+			r := &secretResource{bitwardenClient: client, organizationId: mockOrgId}
+			schema := secretResourceTestSchema(t)
+
+			stateModel := secretResourceModel{
+				ID:             types.StringValue(secret.ID),
+				Key:            types.StringValue(secret.Key),
+				Value:          types.StringValue(secret.Value),
+				Note:           types.StringValue(secret.Note),
+				ProjectID:      types.StringValue(*secret.ProjectID),
+				OrganizationID: types.StringValue(secret.OrganizationID),
+				CreationDate:   types.StringValue(secret.CreationDate.String()),
+				RevisionDate:   types.StringValue(secret.RevisionDate.String()),
+			}
+			planModel := stateModel
+			planModel.Key = types.StringValue("new-key")
+			planModel.Note = test.plannedNote
+			planModel.RevisionDate = types.StringUnknown()
+
+			state := newTestState(t, schema, stateModel)
+			resp := fwresource.UpdateResponse{State: state}
+			newTestPrivateState(&resp.Private)
+			r.Update(context.Background(), fwresource.UpdateRequest{State: state, Plan: newTestPlan(t, schema, planModel)}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			if remote := client.secrets[secret.ID].Note; remote != test.expectedNote {
+				t.Fatalf("expected note %q in Bitwarden Secrets Manager, got: %q", test.expectedNote, remote)
+			}
+
+			readResp := fwresource.ReadResponse{State: resp.State, Private: resp.Private}
+			r.Read(context.Background(), fwresource.ReadRequest{State: resp.State, Private: resp.Private}, &readResp)
+			if readResp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", readResp.Diagnostics)
+			}
+
+			var newState secretResourceModel
+			readResp.State.Get(context.Background(), &newState)
+			if newState.Note.IsNull() || newState.Note.ValueString() != test.expectedNote {
+				t.Fatalf("expected note %q in the state, got: %s", test.expectedNote, newState.Note)
+			}
+		})
+	}
+}
+
+func TestSecretResourceReadNoteChangedOutsideTerraform(t *testing.T) {
+	client := newMockBitwardenClient()
+	client.secretCreateHook = func(key, value, note string, organizationID string, projectIDs []string) (*sdk.SecretResponse, error) {
+		secret := client.addSecret(key, value, strings.TrimSpace(note), organizationID, projectIDs[0])
+		return &secret, nil
+	}
+	r := &secretResource{bitwardenClient: client, organizationId: mockOrgId}
+	schema := secretResourceTestSchema(t)
+
+	createReq := fwresource.CreateRequest{Plan: newTestPlan(t, schema, secretResourceModel{
+		ID:        types.StringUnknown(),
+		Key:       types.StringValue("key"),
+		Value:     types.StringValue("value"),
+		Note:      types.StringValue("note\n"),
+		ProjectID: types.StringValue(validProjectUUID),
+	})}
+	createResp := fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	newTestPrivateState(&createResp.Private)
+	r.Create(context.Background(), createReq, &createResp)
+
+	var created secretResourceModel
+	createResp.State.Get(context.Background(), &created)
+	secret := client.secrets[created.ID.ValueString()]
+	secret.Note = "changed in the web vault"
+	client.secrets[secret.ID] = secret
+
+	readResp := fwresource.ReadResponse{State: createResp.State, Private: createResp.Private}
+	r.Read(context.Background(), fwresource.ReadRequest{State: createResp.State, Private: createResp.Private}, &readResp)
+
+	var state secretResourceModel
+	readResp.State.Get(context.Background(), &state)
+	if state.Note.ValueString() != "changed in the web vault" {
+		t.Fatalf("expected note changed outside of terraform, got: %q", state.Note.ValueString())
+	}
+}
+
+func TestSecretResourceReadWithCancelledContext(t *testing.T) {
+	deadlineExceeded, cancelDeadline := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancelDeadline()
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := map[string]struct {
+		ctx    context.Context
+		reason string
+	}{
+		"cancelled":         {ctx: cancelled, reason: "was cancelled"},
+		"deadline exceeded": {ctx: deadlineExceeded, reason: "deadline of the Terraform operation was exceeded"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := newMockBitwardenClient()
+			secret := client.addSecret("key", "value", "", mockOrgId, validProjectUUID)
+			r := &secretResource{bitwardenClient: client, organizationId: mockOrgId}
+			schema := secretResourceTestSchema(t)
+
+			state := newTestState(t, schema, secretResourceModel{ID: types.StringValue(secret.ID)})
+			resp := fwresource.ReadResponse{State: state}
+			r.Read(test.ctx, fwresource.ReadRequest{State: state}, &resp)
+
+			if !diagnosticsContain(resp.Diagnostics, "Operation Cancelled") || !diagnosticsContain(resp.Diagnostics, test.reason) {
+				t.Fatalf("expected diagnostic about the cancelled operation, got: %v", resp.Diagnostics)
+			}
+			if calls := client.callCount("Secrets.Get"); calls != 0 {
+				t.Fatalf("expected no calls to Secrets.Get, got: %d", calls)
+			}
+		})
+	}
+}
+
+func TestSecretResourceTrackValueByHash(t *testing.T) {
+	client := newMockBitwardenClient()
+	r := &secretResource{bitwardenClient: client, organizationId: mockOrgId}
+	schema := secretResourceTestSchema(t)
+
+	planModel := secretResourceModel{
+		ID:               types.StringUnknown(),
+		Key:              types.StringValue("key"),
+		Value:            types.StringUnknown(),
+		Note:             types.StringValue(""),
+		ProjectID:        types.StringValue(validProjectUUID),
+		TrackValueByHash: types.BoolValue(true),
+		Length:           types.Int64Value(16),
+	}
+	createResp := fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	newTestPrivateState(&createResp.Private)
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: newTestPlan(t, schema, planModel)}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", createResp.Diagnostics)
+	}
+
+	var state secretResourceModel
+	createResp.State.Get(context.Background(), &state)
+	secret := client.secrets[state.ID.ValueString()]
+	if state.Value.ValueString() != hashSecretValue(secret.Value) {
+		t.Fatalf("expected the hash of the value in state, got: %q", state.Value.ValueString())
+	}
+
+	readResp := fwresource.ReadResponse{State: createResp.State}
+	r.Read(context.Background(), fwresource.ReadRequest{State: createResp.State}, &readResp)
+	readResp.State.Get(context.Background(), &state)
+	if state.Value.ValueString() != hashSecretValue(secret.Value) {
+		t.Fatalf("expected the hash of the value in state after read, got: %q", state.Value.ValueString())
+	}
+
+	planModel = state
+	planModel.Value = types.StringUnknown()
+	planModel.Note = types.StringValue("new note")
+	updateResp := fwresource.UpdateResponse{State: readResp.State}
+	newTestPrivateState(&updateResp.Private)
+	r.Update(context.Background(), fwresource.UpdateRequest{State: readResp.State, Plan: newTestPlan(t, schema, planModel)}, &updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", updateResp.Diagnostics)
+	}
+	if updated := client.secrets[state.ID.ValueString()]; updated.Value != secret.Value || updated.Note != "new note" {
+		t.Fatalf("expected the value to be preserved during the update, got: %+v", updated)
+	}
+}
+
+func TestSecretResourceValidateConfigTrackValueByHash(t *testing.T) {
+	r := &secretResource{}
+	schema := secretResourceTestSchema(t)
+	plan := newTestPlan(t, schema, secretResourceModel{
+		Key:              types.StringValue("key"),
+		Value:            types.StringValue("value"),
+		TrackValueByHash: types.BoolValue(true),
+	})
+
+	resp := fwresource.ValidateConfigResponse{}
+	r.ValidateConfig(context.Background(), fwresource.ValidateConfigRequest{Config: tfsdk.Config{Schema: schema, Raw: plan.Raw}}, &resp)
+
+	if !diagnosticsContain(resp.Diagnostics, "Conflicting Secret Value Configuration") {
+		t.Fatalf("expected diagnostic about conflicting value configuration, got: %v", resp.Diagnostics)
+	}
+}
+
+func TestSecretResourceImportStateValidatesType(t *testing.T) {
+	client := newMockBitwardenClient()
+	project := client.addProject(mockOrgId, "project")
+	secret := client.addSecret("key", "value", "", mockOrgId, project.ID)
+	foreignSecret := client.addSecret("key", "value", "", validProjectUUID, project.ID)
+
+	tests := map[string]struct {
+		id      string
+		summary string
+	}{
+		"secret":                       {id: secret.ID},
+		"secret of other organization": {id: foreignSecret.ID, summary: "Imported Secret Belongs to Another Organization"},
+		"project":                      {id: project.ID, summary: "Imported ID Belongs to a Project"},
+		"missing secret":               {id: validProjectUUID, summary: "Cannot Import Non-Existent Secret"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := &secretResource{bitwardenClient: client, organizationId: mockOrgId}
+			schema := secretResourceTestSchema(t)
+
+			resp := fwresource.ImportStateResponse{State: newTestState(t, schema, secretResourceModel{})}
+			newTestPrivateState(&resp.Private)
+			r.ImportState(context.Background(), fwresource.ImportStateRequest{ID: test.id}, &resp)
+
+			if test.summary == "" && resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if test.summary != "" && !diagnosticsContain(resp.Diagnostics, test.summary) {
+				t.Fatalf("expected diagnostic %q, got: %v", test.summary, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestSecretResourceRedactKeys(t *testing.T) {
+	const key = "PAYROLL_DATABASE_URL"
+	client := newMockBitwardenClient()
+	client.secretCreateHook = func(key, _, _ string, _ string, _ []string) (*sdk.SecretResponse, error) {
+		return nil, fmt.Errorf("failed to create secret %s", key)
+	}
+	r := &secretResource{bitwardenClient: client, organizationId: mockOrgId, verboseErrors: true, redactKeys: true}
+	schema := secretResourceTestSchema(t)
+
+	createResp := fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: newTestPlan(t, schema, secretResourceModel{
+		ID:        types.StringUnknown(),
+		Key:       types.StringValue(key),
+		Value:     types.StringValue("value"),
+		ProjectID: types.StringValue(validProjectUUID),
+	})}, &createResp)
+
+	if !createResp.Diagnostics.HasError() || diagnosticsContain(createResp.Diagnostics, key) {
+		t.Fatalf("expected an error without the secret key, got: %v", createResp.Diagnostics)
+	}
+
+	config := newTestPlan(t, schema, secretResourceModel{Key: types.StringValue(key + "\n")})
+	validateResp := fwresource.ValidateConfigResponse{}
+	r.ValidateConfig(context.Background(), fwresource.ValidateConfigRequest{Config: tfsdk.Config{Schema: schema, Raw: config.Raw}}, &validateResp)
+
+	if !diagnosticsContain(validateResp.Diagnostics, displaySecretKey(key+"\n", true)) || diagnosticsContain(validateResp.Diagnostics, key) {
+		t.Fatalf("expected an error referencing the hashed secret key, got: %v", validateResp.Diagnostics)
+	}
+}
+
+// TestSecretResourceCreateBeforeDestroy follows the order of operations of a replacement with the create_before_destroy
+// lifecycle, during which the old and the new secret temporarily exist with the same key.
+func TestSecretResourceCreateBeforeDestroy(t *testing.T) {
+	client := newMockBitwardenClient()
+	r := &secretResource{bitwardenClient: client, organizationId: mockOrgId}
+	schema := secretResourceTestSchema(t)
+	plan := secretResourceModel{
+		ID:        types.StringUnknown(),
+		Key:       types.StringValue("DATABASE_URL"),
+		Value:     types.StringValue("postgres://db"),
+		ProjectID: types.StringValue(validProjectUUID),
+	}
+
+	create := func() tfsdk.State {
+		resp := fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+		newTestPrivateState(&resp.Private)
+		r.Create(context.Background(), fwresource.CreateRequest{Plan: newTestPlan(t, schema, plan)}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error creating secret: %v", resp.Diagnostics)
+		}
+		return resp.State
+	}
+
+	oldState := create()
+	newState := create()
+
+	deleteResp := fwresource.DeleteResponse{State: oldState}
+	r.Delete(context.Background(), fwresource.DeleteRequest{State: oldState}, &deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error deleting the replaced secret: %v", deleteResp.Diagnostics)
+	}
+
+	readResp := fwresource.ReadResponse{State: newState}
+	newTestPrivateState(&readResp.Private)
+	r.Read(context.Background(), fwresource.ReadRequest{State: newState}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error reading the replacing secret: %v", readResp.Diagnostics)
+	}
+
+	var state secretResourceModel
+	readResp.State.Get(context.Background(), &state)
+	if state.ID.IsNull() || state.Value.ValueString() != "postgres://db" {
+		t.Fatalf("expected the replacing secret to remain after the replaced secret was deleted, got: %v", state)
+	}
+}
+
+func TestSecretResourceValueFromSecretId(t *testing.T) {
+	client := newMockBitwardenClient()
+	source := client.addSecret("SOURCE", "first", "", mockOrgId, validProjectUUID)
+	r := &secretResource{bitwardenClient: client, organizationId: mockOrgId}
+	schema := secretResourceTestSchema(t)
+
+	createResp := fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	newTestPrivateState(&createResp.Private)
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: newTestPlan(t, schema, secretResourceModel{
+		ID:                types.StringUnknown(),
+		Key:               types.StringValue("ALIAS"),
+		Value:             types.StringUnknown(),
+		ProjectID:         types.StringValue(validProjectUUID),
+		ValueFromSecretID: types.StringValue(source.ID),
+		SourceValueSha256: types.StringUnknown(),
+	})}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error creating secret: %v", createResp.Diagnostics)
+	}
+
+	var state secretResourceModel
+	createResp.State.Get(context.Background(), &state)
+	if state.Value.ValueString() != "first" || state.SourceValueSha256.ValueString() != hashSecretValue("first") {
+		t.Fatalf("expected the value of the source secret to be copied, got: %v", state)
+	}
+
+	config := tfsdk.Config{Schema: schema, Raw: newTestPlan(t, schema, secretResourceModel{
+		Key:               types.StringValue("ALIAS"),
+		ProjectID:         types.StringValue(validProjectUUID),
+		ValueFromSecretID: types.StringValue(source.ID),
+	}).Raw}
+	modifyPlan := func() secretResourceModel {
+		plan := tfsdk.Plan{Schema: schema, Raw: createResp.State.Raw}
+		resp := fwresource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(context.Background(), fwresource.ModifyPlanRequest{Config: config, Plan: plan, State: createResp.State}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error modifying plan: %v", resp.Diagnostics)
+		}
+		var planned secretResourceModel
+		resp.Plan.Get(context.Background(), &planned)
+		return planned
+	}
+
+	if planned := modifyPlan(); planned.Value.IsUnknown() || !planned.SourceValueSha256.Equal(state.SourceValueSha256) {
+		t.Fatalf("expected no change while the source secret is unchanged, got: %v", planned)
+	}
+
+	if _, err := client.Secrets().Update(source.ID, source.Key, "second", "", mockOrgId, []string{validProjectUUID}); err != nil {
+		t.Fatalf("unexpected error updating source secret: %v", err)
+	}
+
+	if planned := modifyPlan(); !planned.Value.IsUnknown() || planned.SourceValueSha256.ValueString() != hashSecretValue("second") {
+		t.Fatalf("expected the changed source secret to be planned for copy, got: %v", planned)
+	}
+}
+
+func TestSecretResourceValidateConfigValueFromSecretId(t *testing.T) {
+	r := &secretResource{}
+	schema := secretResourceTestSchema(t)
+	config := newTestPlan(t, schema, secretResourceModel{
+		Key:               types.StringValue("ALIAS"),
+		Value:             types.StringValue("value"),
+		ValueFromSecretID: types.StringValue(validProjectUUID),
+	})
+
+	resp := fwresource.ValidateConfigResponse{}
+	r.ValidateConfig(context.Background(), fwresource.ValidateConfigRequest{Config: tfsdk.Config{Schema: schema, Raw: config.Raw}}, &resp)
+
+	if !diagnosticsContain(resp.Diagnostics, "Conflicting Secret Value Configuration") {
+		t.Fatalf("expected an error about the conflicting value configuration, got: %v", resp.Diagnostics)
+	}
+}
+
+func TestSecretResourceUpdateKeepsConcurrentNoteChange(t *testing.T) {
+	client := newMockBitwardenClient()
+	secret := client.addSecret("key", "value", "note", mockOrgId, validProjectUUID)
+	r := &secretResource{bitwardenClient: client, organizationId: mockOrgId}
+	schema := secretResourceTestSchema(t)
+
+	stateModel := secretResourceModel{
+		ID:             types.StringValue(secret.ID),
+		Key:            types.StringValue(secret.Key),
+		Value:          types.StringValue(secret.Value),
+		Note:           types.StringValue(secret.Note),
+		ProjectID:      types.StringValue(*secret.ProjectID),
+		OrganizationID: types.StringValue(secret.OrganizationID),
+		CreationDate:   types.StringValue(secret.CreationDate.String()),
+		RevisionDate:   types.StringValue(secret.RevisionDate.String()),
+	}
+	planModel := stateModel
+	planModel.Key = types.StringValue("new-key")
+	planModel.Note = types.StringUnknown()
+	planModel.RevisionDate = types.StringUnknown()
+
+	// The note is changed by someone else after the plan was created.
+	if _, err := client.Secrets().Update(secret.ID, secret.Key, secret.Value, "concurrent note", mockOrgId, []string{validProjectUUID}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	state := newTestState(t, schema, stateModel)
+	resp := fwresource.UpdateResponse{State: state}
+	newTestPrivateState(&resp.Private)
+	r.Update(context.Background(), fwresource.UpdateRequest{State: state, Plan: newTestPlan(t, schema, planModel)}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	updated, _ := client.Secrets().Get(secret.ID)
+	if updated.Key != "new-key" || updated.Note != "concurrent note" {
+		t.Fatalf("expected the key to be updated and the concurrent note to be kept, got: %+v", updated)
+	}
+}
+
+func TestSecretResourceUpdateMovesSecret(t *testing.T) {
+	client := newMockBitwardenClient()
+	sourceProject := client.addProject(mockOrgId, "source")
+	targetProject := client.addProject(mockOrgId, "target")
+
+	tests := map[string]struct {
+		targetProjectId string
+		expectError     bool
+	}{
+		"existing project": {targetProjectId: targetProject.ID},
+		"missing project":  {targetProjectId: validProjectUUID, expectError: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			secret := client.addSecret("key", "value", "note", mockOrgId, sourceProject.ID)
+			r := &secretResource{bitwardenClient: client, organizationId: mockOrgId}
+			schema := secretResourceTestSchema(t)
+
+			stateModel := secretResourceModel{
+				ID:             types.StringValue(secret.ID),
+				Key:            types.StringValue(secret.Key),
+				Value:          types.StringValue(secret.Value),
+				Note:           types.StringValue(secret.Note),
+				ProjectID:      types.StringValue(sourceProject.ID),
+				OrganizationID: types.StringValue(secret.OrganizationID),
+				CreationDate:   types.StringValue(secret.CreationDate.String()),
+				RevisionDate:   types.StringValue(secret.RevisionDate.String()),
+			}
+			planModel := stateModel
+			planModel.ProjectID = types.StringValue(test.targetProjectId)
+			planModel.RevisionDate = types.StringUnknown()
+
+			state := newTestState(t, schema, stateModel)
+			resp := fwresource.UpdateResponse{State: state}
+			newTestPrivateState(&resp.Private)
+			r.Update(context.Background(), fwresource.UpdateRequest{State: state, Plan: newTestPlan(t, schema, planModel)}, &resp)
+
+			if resp.Diagnostics.HasError() != test.expectError {
+				t.Fatalf("expected error to be %t, got: %v", test.expectError, resp.Diagnostics)
+			}
+			if test.expectError {
+				return
+			}
+
+			var newState secretResourceModel
+			resp.State.Get(context.Background(), &newState)
+			if newState.ID.ValueString() != secret.ID || newState.ProjectID.ValueString() != targetProject.ID ||
+				newState.Value.ValueString() != "value" || newState.Note.ValueString() != "note" {
+				t.Fatalf("expected the secret to be moved with its ID, value and note, got: %+v", newState)
+			}
+		})
+	}
+}
+
+func TestSecretResourceKeyCase(t *testing.T) {
+	client := newMockBitwardenClient()
+	r := &secretResource{bitwardenClient: client, organizationId: mockOrgId}
+	schema := secretResourceTestSchema(t)
+
+	plan := secretResourceModel{
+		ID:        types.StringUnknown(),
+		Key:       types.StringValue("database_url"),
+		KeyCase:   types.StringValue("upper"),
+		Value:     types.StringValue("postgres://db"),
+		ProjectID: types.StringValue(validProjectUUID),
+	}
+	createResp := fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	newTestPrivateState(&createResp.Private)
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: newTestPlan(t, schema, plan)}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error creating secret: %v", createResp.Diagnostics)
+	}
+
+	var state secretResourceModel
+	createResp.State.Get(context.Background(), &state)
+	if remote := client.secrets[state.ID.ValueString()]; remote.Key != "DATABASE_URL" {
+		t.Fatalf("expected the key to be stored in uppercase, got: %q", remote.Key)
+	}
+	if state.Key.ValueString() != "database_url" {
+		t.Fatalf("expected the configured key to be kept in the state, got: %q", state.Key.ValueString())
+	}
+
+	readResp := fwresource.ReadResponse{State: createResp.State}
+	r.Read(context.Background(), fwresource.ReadRequest{State: createResp.State}, &readResp)
+	readResp.State.Get(context.Background(), &state)
+	if state.Key.ValueString() != "database_url" {
+		t.Fatalf("expected the configured key to be kept after a refresh, got: %q", state.Key.ValueString())
+	}
+
+	plan = state
+	plan.KeyCase = types.StringValue("lower")
+	updateResp := fwresource.UpdateResponse{State: readResp.State}
+	newTestPrivateState(&updateResp.Private)
+	r.Update(context.Background(), fwresource.UpdateRequest{State: readResp.State, Plan: newTestPlan(t, schema, plan)}, &updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error updating secret: %v", updateResp.Diagnostics)
+	}
+	updateResp.State.Get(context.Background(), &state)
+	if remote := client.secrets[state.ID.ValueString()]; remote.Key != "database_url" || state.Key.ValueString() != "database_url" {
+		t.Fatalf("expected the key to be updated to lowercase, got: %q in the state and %q remotely", state.Key.ValueString(), remote.Key)
+	}
+
+	remote := client.secrets[state.ID.ValueString()]
+	remote.Key = "OTHER_KEY"
+	client.secrets[remote.ID] = remote
+	readResp = fwresource.ReadResponse{State: updateResp.State}
+	r.Read(context.Background(), fwresource.ReadRequest{State: updateResp.State}, &readResp)
+	readResp.State.Get(context.Background(), &state)
+	if state.Key.ValueString() != "OTHER_KEY" {
+		t.Fatalf("expected a key changed outside of Terraform to be read, got: %q", state.Key.ValueString())
+	}
+}
+
+func TestSecretResourceContentVersion(t *testing.T) {
+	if !contentVersion("salt", "value", "note").Equal(contentVersion("salt", "value", "note")) {
+		t.Fatal("expected the content version to be deterministic")
+	}
+	if contentVersion("salt", "ab", "c").Equal(contentVersion("salt", "a", "bc")) || contentVersion("salt", "value", "note").Equal(contentVersion("salt", "value", "other")) {
+		t.Fatal("expected the content version to change with the content")
+	}
+	if contentVersion("salt", "value", "note").Equal(contentVersion("other salt", "value", "note")) {
+		t.Fatal("expected the content version to depend on the salt")
+	}
+
+	client := newMockBitwardenClient()
+	r := &secretResource{bitwardenClient: client, organizationId: mockOrgId}
+	schema := secretResourceTestSchema(t)
+
+	createResp := fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	newTestPrivateState(&createResp.Private)
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: newTestPlan(t, schema, secretResourceModel{
+		ID:        types.StringUnknown(),
+		Key:       types.StringValue("KEY"),
+		Value:     types.StringUnknown(),
+		Note:      types.StringUnknown(),
+		ProjectID: types.StringValue(validProjectUUID),
+		Length:    types.Int64Value(16),
+	})}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error creating secret: %v", createResp.Diagnostics)
+	}
+
+	var state secretResourceModel
+	createResp.State.Get(context.Background(), &state)
+	storedSalt, _ := createResp.Private.GetKey(context.Background(), contentVersionSaltPrivateKey)
+	var salt string
+	if err := json.Unmarshal(storedSalt, &salt); err != nil || salt == "" {
+		t.Fatalf("expected a salt in the private state, got: %q", storedSalt)
+	}
+	if !state.ContentVersion.Equal(contentVersion(salt, state.Value.ValueString(), "")) {
+		t.Fatalf("expected the content version of the created secret, got: %v", state.ContentVersion)
+	}
+	if state.ContentVersion.Equal(types.StringValue(hashSecretValue(fmt.Sprintf("%d:%s", len(state.Value.ValueString()), state.Value.ValueString())))) {
+		t.Fatal("expected the content version not to be the unsalted hash of the content")
+	}
+
+	tests := map[string]struct {
+		configure       func(config *secretResourceModel, plan *secretResourceModel)
+		expectUnchanged bool
+	}{
+		"key renamed": {
+			configure: func(config *secretResourceModel, plan *secretResourceModel) {
+				config.Key = types.StringValue("RENAMED")
+				plan.Key = config.Key
+			},
+			expectUnchanged: true,
+		},
+		"note changed": {
+			configure: func(config *secretResourceModel, plan *secretResourceModel) {
+				config.Note = types.StringValue("note")
+				plan.Note = config.Note
+			},
+		},
+		"value regenerated": {
+			configure: func(config *secretResourceModel, plan *secretResourceModel) {
+				config.Length = types.Int64Value(32)
+				plan.Length = config.Length
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := secretResourceModel{Key: state.Key, ProjectID: state.ProjectID, Length: state.Length}
+			plan := state
+			plan.Value = types.StringUnknown()
+			plan.Note = types.StringUnknown()
+			plan.RevisionDate = types.StringUnknown()
+			plan.ContentVersion = types.StringUnknown()
+			test.configure(&config, &plan)
+
+			planned := newTestPlan(t, schema, plan)
+			resp := fwresource.ModifyPlanResponse{Plan: planned}
+			r.ModifyPlan(context.Background(), fwresource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: schema, Raw: newTestPlan(t, schema, config).Raw},
+				Plan:   planned,
+				State:  createResp.State,
+			}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error modifying plan: %v", resp.Diagnostics)
+			}
+
+			var result secretResourceModel
+			resp.Plan.Get(context.Background(), &result)
+			if result.ContentVersion.Equal(state.ContentVersion) != test.expectUnchanged {
+				t.Fatalf("expected the content version to be unchanged to be %t, got: %v", test.expectUnchanged, result.ContentVersion)
+			}
+			if result.ValueIsEmpty.IsUnknown() != (name == "value regenerated") {
+				t.Fatalf("expected value_is_empty to be unknown only if the value changes, got: %v", result.ValueIsEmpty)
+			}
+		})
+	}
+}
+
+func TestSecretResourceValueIsEmpty(t *testing.T) {
+	client := newMockBitwardenClient()
+	r := &secretResource{bitwardenClient: client, organizationId: mockOrgId}
+	schema := secretResourceTestSchema(t)
+
+	createResp := fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	newTestPrivateState(&createResp.Private)
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: newTestPlan(t, schema, secretResourceModel{
+		ID:        types.StringUnknown(),
+		Key:       types.StringValue("PLACEHOLDER"),
+		Value:     types.StringValue(""),
+		Note:      types.StringUnknown(),
+		ProjectID: types.StringValue(validProjectUUID),
+	})}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error creating secret: %v", createResp.Diagnostics)
+	}
+
+	var state secretResourceModel
+	createResp.State.Get(context.Background(), &state)
+	if !state.ValueIsEmpty.Equal(types.BoolValue(true)) {
+		t.Fatalf("expected value_is_empty to be true, got: %v", state.ValueIsEmpty)
+	}
+
+	// The value is filled outside of Terraform and read from Bitwarden Secrets Manager.
+	secret := client.secrets[state.ID.ValueString()]
+	secret.Value = "filled"
+	client.secrets[secret.ID] = secret
+
+	readResp := fwresource.ReadResponse{State: createResp.State}
+	newTestPrivateState(&readResp.Private)
+	r.Read(context.Background(), fwresource.ReadRequest{State: createResp.State}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error reading secret: %v", readResp.Diagnostics)
+	}
+	readResp.State.Get(context.Background(), &state)
+	if !state.ValueIsEmpty.Equal(types.BoolValue(false)) {
+		t.Fatalf("expected value_is_empty to be false, got: %v", state.ValueIsEmpty)
+	}
+}
+
+func TestSecretResourceValueLength(t *testing.T) {
+	client := newMockBitwardenClient()
+	r := &secretResource{bitwardenClient: client, organizationId: mockOrgId}
+	schema := secretResourceTestSchema(t)
+
+	config := secretResourceModel{Key: types.StringValue("key"), Value: types.StringValue("pässwörd"), ProjectID: types.StringValue(validProjectUUID)}
+	plan := config
+	plan.ID = types.StringUnknown()
+	plan.Note = types.StringUnknown()
+	plan.ValueLength = types.Int64Unknown()
+
+	// The length of a configured value is shown in the plan of a new secret.
+	planned := newTestPlan(t, schema, plan)
+	planResp := fwresource.ModifyPlanResponse{Plan: planned}
+	r.ModifyPlan(context.Background(), fwresource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: schema, Raw: newTestPlan(t, schema, config).Raw},
+		Plan:   planned,
+		State:  tfsdk.State{Schema: schema, Raw: tftypes.NewValue(schema.Type().TerraformType(context.Background()), nil)},
+	}, &planResp)
+	if planResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error modifying plan: %v", planResp.Diagnostics)
+	}
+	var result secretResourceModel
+	planResp.Plan.Get(context.Background(), &result)
+	if !result.ValueLength.Equal(types.Int64Value(8)) {
+		t.Fatalf("expected a planned value_length of 8, got: %v", result.ValueLength)
+	}
+
+	createResp := fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	newTestPrivateState(&createResp.Private)
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: planResp.Plan}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error creating secret: %v", createResp.Diagnostics)
+	}
+	var state secretResourceModel
+	createResp.State.Get(context.Background(), &state)
+	if !state.ValueLength.Equal(types.Int64Value(8)) {
+		t.Fatalf("expected value_length to be 8, got: %v", state.ValueLength)
+	}
+
+	tests := map[string]struct {
+		value    types.String
+		expected types.Int64
+	}{
+		"value unchanged": {value: types.StringValue("pässwörd"), expected: types.Int64Value(8)},
+		"value truncated": {value: types.StringValue("päss"), expected: types.Int64Value(4)},
+		"value generated": {value: types.StringNull(), expected: types.Int64Unknown()},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := secretResourceModel{Key: state.Key, Value: test.value, ProjectID: state.ProjectID}
+			plan := state
+			plan.Value = test.value
+			if test.value.IsNull() {
+				plan.Value = types.StringUnknown()
+				plan.Length = types.Int64Value(32)
+			}
+
+			planned := newTestPlan(t, schema, plan)
+			resp := fwresource.ModifyPlanResponse{Plan: planned}
+			r.ModifyPlan(context.Background(), fwresource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: schema, Raw: newTestPlan(t, schema, config).Raw},
+				Plan:   planned,
+				State:  createResp.State,
+			}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error modifying plan: %v", resp.Diagnostics)
+			}
+
+			var result secretResourceModel
+			resp.Plan.Get(context.Background(), &result)
+			if !result.ValueLength.Equal(test.expected) {
+				t.Fatalf("expected a planned value_length of %v, got: %v", test.expected, result.ValueLength)
+			}
+		})
+	}
+
+	// A value changed outside of Terraform is read from Bitwarden Secrets Manager.
+	secret := client.secrets[state.ID.ValueString()]
+	secret.Value = ""
+	client.secrets[secret.ID] = secret
+
+	readResp := fwresource.ReadResponse{State: createResp.State}
+	newTestPrivateState(&readResp.Private)
+	r.Read(context.Background(), fwresource.ReadRequest{State: createResp.State}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error reading secret: %v", readResp.Diagnostics)
+	}
+	readResp.State.Get(context.Background(), &state)
+	if !state.ValueLength.Equal(types.Int64Value(0)) {
+		t.Fatalf("expected value_length to be 0, got: %v", state.ValueLength)
+	}
+}
+
+func TestSecretResourceRefreshTtl(t *testing.T) {
+	client := newMockBitwardenClient()
+	r := &secretResource{bitwardenClient: client, organizationId: mockOrgId, refreshTtl: time.Hour}
+	schema := secretResourceTestSchema(t)
+
+	createResp := fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	newTestPrivateState(&createResp.Private)
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: newTestPlan(t, schema, secretResourceModel{
+		ID:        types.StringUnknown(),
+		Key:       types.StringValue("key"),
+		Value:     types.StringValue("value"),
+		ProjectID: types.StringValue(validProjectUUID),
+	})}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error creating secret: %v", createResp.Diagnostics)
+	}
+
+	var state secretResourceModel
+	createResp.State.Get(context.Background(), &state)
+	if _, err := client.Secrets().Update(state.ID.ValueString(), "key", "changed", "", mockOrgId, []string{validProjectUUID}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	read := func() secretResourceModel {
+		readResp := fwresource.ReadResponse{State: createResp.State, Private: createResp.Private}
+		r.Read(context.Background(), fwresource.ReadRequest{State: createResp.State, Private: createResp.Private}, &readResp)
+		if readResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error reading secret: %v", readResp.Diagnostics)
+		}
+		var readState secretResourceModel
+		readResp.State.Get(context.Background(), &readState)
+		return readState
+	}
+
+	getCalls := client.callCount("Secrets.Get")
+	if readState := read(); readState.Value.ValueString() != "value" || client.callCount("Secrets.Get") != getCalls {
+		t.Fatalf("expected the recently created secret not to be read again, got: %q", readState.Value.ValueString())
+	}
+
+	expired, _ := json.Marshal(time.Now().Add(-2 * time.Hour))
+	createResp.Private.SetKey(context.Background(), lastReadPrivateKey, expired)
+	if readState := read(); readState.Value.ValueString() != "changed" {
+		t.Fatalf("expected the secret to be read again once the TTL passed, got: %q", readState.Value.ValueString())
+	}
+
+	t.Setenv("BW_FORCE_REFRESH", "true")
+	if ttl := refreshTtl(types.Int64Value(3600)); ttl != 0 {
+		t.Fatalf("expected BW_FORCE_REFRESH to disable the TTL, got: %v", ttl)
+	}
+}
+
+func TestSecretResourceWarnValueInState(t *testing.T) {
+	schema := secretResourceTestSchema(t)
+	stored := secretResourceModel{
+		ID:        types.StringValue(validProjectUUID),
+		Key:       types.StringValue("key"),
+		Value:     types.StringValue("value"),
+		ProjectID: types.StringValue(validProjectUUID),
+	}
+
+	tests := map[string]struct {
+		value            types.String
+		state            *secretResourceModel
+		warnValueInState bool
+		expectWarning    bool
+	}{
+		"new value":       {value: types.StringValue("value"), warnValueInState: true, expectWarning: true},
+		"changed value":   {value: types.StringValue("new"), state: &stored, warnValueInState: true, expectWarning: true},
+		"unchanged value": {value: types.StringValue("value"), state: &stored, warnValueInState: true},
+		"generated value": {value: types.StringNull(), warnValueInState: true},
+		"suppressed":      {value: types.StringValue("value")},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := &secretResource{warnValueInState: test.warnValueInState}
+			plan := newTestPlan(t, schema, secretResourceModel{
+				Key:       types.StringValue("key"),
+				Value:     test.value,
+				ProjectID: types.StringValue(validProjectUUID),
+			})
+			state := tfsdk.State{Schema: schema}
+			if test.state != nil {
+				state = newTestState(t, schema, *test.state)
+			}
+
+			resp := fwresource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(context.Background(), fwresource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: schema, Raw: plan.Raw},
+				Plan:   plan,
+				State:  state,
+			}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if diagnosticsContain(resp.Diagnostics, "Secret Value Stored in Terraform State") != test.expectWarning {
+				t.Fatalf("expected warning to be %t, got: %v", test.expectWarning, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestSecretResourceValidateProjectOrganization(t *testing.T) {
+	const otherOrgId = "7d3e5f1a-2b4c-4d6e-8f0a-1b2c3d4e5f60"
+	client := newMockBitwardenClient()
+	ownProject := client.addProject(mockOrgId, "own")
+	foreignProject := client.addProject(otherOrgId, "foreign")
+	schema := secretResourceTestSchema(t)
+
+	tests := map[string]struct {
+		projectId                   string
+		validateProjectOrganization bool
+		expectError                 bool
+	}{
+		"own project":                   {projectId: ownProject.ID, validateProjectOrganization: true},
+		"foreign project":               {projectId: foreignProject.ID, validateProjectOrganization: true, expectError: true},
+		"foreign project not validated": {projectId: foreignProject.ID},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := &secretResource{bitwardenClient: client, organizationId: mockOrgId, validateProjectOrganization: test.validateProjectOrganization}
+			getCalls := client.callCount("Projects.Get")
+
+			resp := fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+			newTestPrivateState(&resp.Private)
+			r.Create(context.Background(), fwresource.CreateRequest{Plan: newTestPlan(t, schema, secretResourceModel{
+				ID:        types.StringUnknown(),
+				Key:       types.StringValue("key"),
+				Value:     types.StringValue("value"),
+				ProjectID: types.StringValue(test.projectId),
+			})}, &resp)
+
+			if resp.Diagnostics.HasError() != test.expectError {
+				t.Fatalf("expected error to be %t, got: %v", test.expectError, resp.Diagnostics)
+			}
+			if test.expectError && !diagnosticsContain(resp.Diagnostics, "Project Belongs to Another Organization") {
+				t.Fatalf("expected an organization mismatch, got: %v", resp.Diagnostics)
+			}
+			if validated := client.callCount("Projects.Get") > getCalls; validated != test.validateProjectOrganization {
+				t.Fatalf("expected the project to be read to be %t", test.validateProjectOrganization)
+			}
+		})
+	}
+
+	t.Run("move to foreign project", func(t *testing.T) {
+		secret := client.addSecret("key", "value", "", mockOrgId, ownProject.ID)
+		r := &secretResource{bitwardenClient: client, organizationId: mockOrgId, validateProjectOrganization: true}
+
+		stateModel := secretResourceModel{
+			ID:             types.StringValue(secret.ID),
+			Key:            types.StringValue(secret.Key),
+			Value:          types.StringValue(secret.Value),
+			Note:           types.StringValue(secret.Note),
+			ProjectID:      types.StringValue(ownProject.ID),
+			OrganizationID: types.StringValue(mockOrgId),
+		}
+		planModel := stateModel
+		planModel.ProjectID = types.StringValue(foreignProject.ID)
+
+		state := newTestState(t, schema, stateModel)
+		resp := fwresource.UpdateResponse{State: state}
+		r.Update(context.Background(), fwresource.UpdateRequest{State: state, Plan: newTestPlan(t, schema, planModel)}, &resp)
+		if !diagnosticsContain(resp.Diagnostics, "Project Belongs to Another Organization") {
+			t.Fatalf("expected an organization mismatch, got: %v", resp.Diagnostics)
+		}
+	})
+}
+
+func TestSecretResourceExpectedValueSha256(t *testing.T) {
+	schema := secretResourceTestSchema(t)
+	valueHash := hashSecretValue("value")
+
+	tests := map[string]struct {
+		expected         types.String
+		trackValueByHash bool
+		noState          bool
+		expectError      bool
+	}{
+		"matching hash":            {expected: types.StringValue(valueHash)},
+		"matching uppercase hash":  {expected: types.StringValue(strings.ToUpper(valueHash))},
+		"mismatching hash":         {expected: types.StringValue(hashSecretValue("other")), expectError: true},
+		"tracked by hash matching": {expected: types.StringValue(valueHash), trackValueByHash: true},
+		"tracked by hash mismatch": {expected: types.StringValue(hashSecretValue("other")), trackValueByHash: true, expectError: true},
+		"not configured":           {expected: types.StringNull()},
+		"new secret":               {expected: types.StringValue(hashSecretValue("other")), noState: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := &secretResource{}
+			stored := secretResourceModel{
+				ID:               types.StringValue(validProjectUUID),
+				Key:              types.StringValue("key"),
+				Value:            stateValue("value", types.BoolValue(test.trackValueByHash)),
+				ProjectID:        types.StringValue(validProjectUUID),
+				TrackValueByHash: types.BoolValue(test.trackValueByHash),
+			}
+			plan := newTestPlan(t, schema, secretResourceModel{
+				Key:                 types.StringValue("key"),
+				ProjectID:           types.StringValue(validProjectUUID),
+				TrackValueByHash:    types.BoolValue(test.trackValueByHash),
+				ExpectedValueSha256: test.expected,
+			})
+			state := newTestState(t, schema, stored)
+			if test.noState {
+				state = tfsdk.State{Schema: schema}
+			}
+
+			resp := fwresource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(context.Background(), fwresource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: schema, Raw: plan.Raw},
+				Plan:   plan,
+				State:  state,
+			}, &resp)
+			if diagnosticsContain(resp.Diagnostics, "Secret Value Does Not Match Expected Hash") != test.expectError {
+				t.Fatalf("expected error to be %t, got: %v", test.expectError, resp.Diagnostics)
+			}
+			for _, d := range resp.Diagnostics {
+				if strings.Contains(d.Detail(), valueHash) {
+					t.Fatalf("expected diagnostic not to reveal the hash of the value, got: %s", d.Detail())
+				}
+			}
+		})
+	}
+}
+
+func TestSecretResourceProjectName(t *testing.T) {
+	schema := secretResourceTestSchema(t)
+
+	tests := map[string]struct {
+		existingProjects       []string
+		createProjectIfMissing bool
+		expectError            string
+		expectProjectCreated   bool
+	}{
+		"existing project":                {existingProjects: []string{"app", "other"}},
+		"existing project not created":    {existingProjects: []string{"app"}, createProjectIfMissing: true},
+		"missing project":                 {existingProjects: []string{"other"}, expectError: "Project Not Found"},
+		"missing project created":         {existingProjects: []string{"other"}, createProjectIfMissing: true, expectProjectCreated: true},
+		"ambiguous project":               {existingProjects: []string{"app", "app"}, expectError: "Ambiguous Project Name"},
+		"ambiguous project never created": {existingProjects: []string{"app", "app"}, createProjectIfMissing: true, expectError: "Ambiguous Project Name"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := newMockBitwardenClient()
+			for _, projectName := range test.existingProjects {
+				client.addProject(mockOrgId, projectName)
+			}
+			r := &secretResource{bitwardenClient: client, organizationId: mockOrgId}
+
+			resp := fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+			newTestPrivateState(&resp.Private)
+			r.Create(context.Background(), fwresource.CreateRequest{Plan: newTestPlan(t, schema, secretResourceModel{
+				ID:                     types.StringUnknown(),
+				Key:                    types.StringValue("key"),
+				Value:                  types.StringValue("value"),
+				ProjectID:              types.StringUnknown(),
+				ProjectName:            types.StringValue("app"),
+				CreateProjectIfMissing: types.BoolValue(test.createProjectIfMissing),
+			})}, &resp)
+
+			if test.expectError != "" {
+				if !diagnosticsContain(resp.Diagnostics, test.expectError) {
+					t.Fatalf("expected error %q, got: %v", test.expectError, resp.Diagnostics)
+				}
+				if client.callCount("Secrets.Create") > 0 || client.callCount("Projects.Create") > 0 {
+					t.Fatal("expected neither a secret nor a project to be created")
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if created := client.callCount("Projects.Create") > 0; created != test.expectProjectCreated {
+				t.Fatalf("expected the project to be created to be %t", test.expectProjectCreated)
+			}
+
+			var state secretResourceModel
+			resp.State.Get(context.Background(), &state)
+			if project := client.projects[state.ProjectID.ValueString()]; project.Name != "app" {
+				t.Fatalf("expected the secret to belong to the project named app, got: %q", project.Name)
+			}
+			if state.ProjectName.ValueString() != "app" {
+				t.Fatalf("expected project_name to be kept in the state, got: %s", state.ProjectName)
+			}
+
+			// Destroying the secret never deletes the project.
+			deleteResp := fwresource.DeleteResponse{State: resp.State}
+			r.Delete(context.Background(), fwresource.DeleteRequest{State: resp.State}, &deleteResp)
+			if deleteResp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", deleteResp.Diagnostics)
+			}
+			if client.callCount("Projects.Delete") > 0 {
+				t.Fatal("expected the project not to be deleted")
+			}
+			if _, ok := client.projects[state.ProjectID.ValueString()]; !ok {
+				t.Fatal("expected the project to remain")
+			}
+		})
+	}
+
+	t.Run("renamed project moves secret", func(t *testing.T) {
+		client := newMockBitwardenClient()
+		oldProject := client.addProject(mockOrgId, "old")
+		newProject := client.addProject(mockOrgId, "new")
+		secret := client.addSecret("key", "value", "", mockOrgId, oldProject.ID)
+		r := &secretResource{bitwardenClient: client, organizationId: mockOrgId}
+
+		stateModel := secretResourceModel{
+			ID:             types.StringValue(secret.ID),
+			Key:            types.StringValue(secret.Key),
+			Value:          types.StringValue(secret.Value),
+			Note:           types.StringValue(secret.Note),
+			ProjectID:      types.StringValue(oldProject.ID),
+			ProjectName:    types.StringValue("old"),
+			OrganizationID: types.StringValue(mockOrgId),
+		}
+		planModel := stateModel
+		planModel.ProjectID = types.StringUnknown()
+		planModel.ProjectName = types.StringValue("new")
+
+		state := newTestState(t, schema, stateModel)
+		resp := fwresource.UpdateResponse{State: state}
+		newTestPrivateState(&resp.Private)
+		r.Update(context.Background(), fwresource.UpdateRequest{State: state, Plan: newTestPlan(t, schema, planModel)}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %v", resp.Diagnostics)
+		}
+		if projectId := *client.secrets[secret.ID].ProjectID; projectId != newProject.ID {
+			t.Fatalf("expected the secret to be moved to %s, got: %s", newProject.ID, projectId)
+		}
+	})
+}
+
+func TestSecretResourceValidateConfigProjectName(t *testing.T) {
+	schema := secretResourceTestSchema(t)
+
+	tests := map[string]struct {
+		config      secretResourceModel
+		expectError string
+	}{
+		"project name": {
+			config: secretResourceModel{Key: types.StringValue("key"), ProjectName: types.StringValue("app"), CreateProjectIfMissing: types.BoolValue(true)},
+		},
+		"project name and id": {
+			config:      secretResourceModel{Key: types.StringValue("key"), ProjectName: types.StringValue("app"), ProjectID: types.StringValue(validProjectUUID)},
+			expectError: "Conflicting Project Configuration",
+		},
+		"create without project name": {
+			config:      secretResourceModel{Key: types.StringValue("key"), ProjectID: types.StringValue(validProjectUUID), CreateProjectIfMissing: types.BoolValue(true)},
+			expectError: "Missing Project Name",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := &secretResource{}
+			plan := newTestPlan(t, schema, test.config)
+			resp := fwresource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), fwresource.ValidateConfigRequest{Config: tfsdk.Config{Schema: schema, Raw: plan.Raw}}, &resp)
+
+			if test.expectError == "" && resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if test.expectError != "" && !diagnosticsContain(resp.Diagnostics, test.expectError) {
+				t.Fatalf("expected error %q, got: %v", test.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestSecretResourceMetadataOnly(t *testing.T) {
+	schema := secretResourceTestSchema(t)
+
+	tests := map[string]struct {
+		metadataOnly bool
+		getError     string
+		listed       bool
+		expectError  bool
+	}{
+		"value not found":                 {metadataOnly: true, getError: "API error: [404 Not Found] Resource not found", listed: true},
+		"value forbidden":                 {metadataOnly: true, getError: "API error: [403 Forbidden]", listed: true},
+		"metadata_only disabled":          {getError: "API error: [404 Not Found] Resource not found", listed: true, expectError: true},
+		"secret not listed":               {metadataOnly: true, getError: "API error: [404 Not Found] Resource not found", expectError: true},
+		"other errors are not tolerated":  {metadataOnly: true, getError: "API error: [500 Internal Server Error]", listed: true, expectError: true},
+		"readable value is read as usual": {metadataOnly: true, listed: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := newMockBitwardenClient()
+			secret := client.addSecret("KEY", "value", "note", mockOrgId, validProjectUUID)
+			if test.getError != "" {
+				client.secretGetHook = func(secretID string) (*sdk.SecretResponse, error) {
+					return nil, errors.New(test.getError)
+				}
+			}
+			if !test.listed {
+				client.secretListHook = func(organizationID string) (*sdk.SecretIdentifiersResponse, error) {
+					return &sdk.SecretIdentifiersResponse{}, nil
+				}
+			}
+			r := &secretResource{bitwardenClient: client, organizationId: mockOrgId, metadataOnly: test.metadataOnly}
+
+			// The state of an imported secret only contains its ID.
+			state := newTestState(t, schema, secretResourceModel{ID: types.StringValue(secret.ID)})
+			resp := fwresource.ReadResponse{State: state}
+			newTestPrivateState(&resp.Private)
+			r.Read(context.Background(), fwresource.ReadRequest{State: state, Private: resp.Private}, &resp)
+
+			if resp.Diagnostics.HasError() != test.expectError {
+				t.Fatalf("expected error to be %t, got: %v", test.expectError, resp.Diagnostics)
+			}
+			if test.expectError {
+				return
+			}
+
+			var newState secretResourceModel
+			resp.State.Get(context.Background(), &newState)
+			if newState.Key.ValueString() != "KEY" || newState.ProjectID.ValueString() != validProjectUUID || newState.OrganizationID.ValueString() != mockOrgId {
+				t.Fatalf("expected the metadata to be read, got: %v", newState)
+			}
+			metadataRead := diagnosticsContain(resp.Diagnostics, "Secret Value Not Readable")
+			if metadataRead != (test.getError != "") {
+				t.Fatalf("expected the metadata-only warning to be %t, got: %v", test.getError != "", resp.Diagnostics)
+			}
+			if metadataRead && !newState.Value.IsNull() {
+				t.Fatalf("expected the value to be left unset, got: %s", newState.Value)
+			}
+			if !metadataRead && newState.Value.ValueString() != "value" {
+				t.Fatalf("expected the value to be read, got: %s", newState.Value)
+			}
+		})
+	}
+
+	t.Run("import", func(t *testing.T) {
+		client := newMockBitwardenClient()
+		secret := client.addSecret("KEY", "value", "", mockOrgId, validProjectUUID)
+		client.secretGetHook = func(secretID string) (*sdk.SecretResponse, error) {
+			return nil, errors.New("API error: [404 Not Found] Resource not found")
+		}
+
+		for _, metadataOnly := range []bool{false, true} {
+			r := &secretResource{bitwardenClient: client, organizationId: mockOrgId, metadataOnly: metadataOnly}
+			resp := fwresource.ImportStateResponse{State: newTestState(t, schema, secretResourceModel{})}
+			newTestPrivateState(&resp.Private)
+			r.ImportState(context.Background(), fwresource.ImportStateRequest{ID: secret.ID}, &resp)
+			if resp.Diagnostics.HasError() == metadataOnly {
+				t.Fatalf("expected the import to succeed to be %t, got: %v", metadataOnly, resp.Diagnostics)
+			}
+		}
+	})
+}
+
+func TestSecretResourceForceNewValueOnImport(t *testing.T) {
+	schema := secretResourceTestSchema(t)
+
+	for _, force := range []bool{false, true} {
+		t.Run(fmt.Sprintf("force %t", force), func(t *testing.T) {
+			client := newMockBitwardenClient()
+			secret := client.addSecret("key", "remote", "", mockOrgId, validProjectUUID)
+			r := &secretResource{bitwardenClient: client, organizationId: mockOrgId}
+
+			importResp := fwresource.ImportStateResponse{State: newTestState(t, schema, secretResourceModel{})}
+			newTestPrivateState(&importResp.Private)
+			r.ImportState(context.Background(), fwresource.ImportStateRequest{ID: secret.ID}, &importResp)
+			if importResp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", importResp.Diagnostics)
+			}
+
+			stateModel := secretResourceModel{
+				ID:             types.StringValue(secret.ID),
+				Key:            types.StringValue("key"),
+				Value:          types.StringValue("remote"),
+				Note:           types.StringValue(""),
+				ProjectID:      types.StringValue(validProjectUUID),
+				OrganizationID: types.StringValue(mockOrgId),
+				Length:         types.Int64Value(8),
+			}
+			planModel := stateModel
+			planModel.ForceNewValueOnImport = types.BoolValue(force)
+			configModel := planModel
+			configModel.Value = types.StringNull()
+			state := newTestState(t, schema, stateModel)
+			plan := newTestPlan(t, schema, planModel)
+
+			planResp := fwresource.ModifyPlanResponse{Plan: plan, Private: importResp.Private}
+			r.ModifyPlan(context.Background(), fwresource.ModifyPlanRequest{
+				Config:  tfsdk.Config{Schema: schema, Raw: newTestPlan(t, schema, configModel).Raw},
+				Plan:    plan,
+				State:   state,
+				Private: importResp.Private,
+			}, &planResp)
+			if planResp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", planResp.Diagnostics)
+			}
+			if diagnosticsContain(planResp.Diagnostics, "Imported Secret Value Will Be Replaced") != force {
+				t.Fatalf("expected the replacement warning to be %t, got: %v", force, planResp.Diagnostics)
+			}
+
+			var planned secretResourceModel
+			planResp.Plan.Get(context.Background(), &planned)
+			if planned.Value.IsUnknown() != force {
+				t.Fatalf("expected the value to be planned as unknown to be %t, got: %s", force, planned.Value)
+			}
+
+			updateResp := fwresource.UpdateResponse{State: state, Private: importResp.Private}
+			r.Update(context.Background(), fwresource.UpdateRequest{State: state, Plan: planResp.Plan, Private: importResp.Private}, &updateResp)
+			if updateResp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", updateResp.Diagnostics)
+			}
+
+			if replaced := client.secrets[secret.ID].Value != "remote"; replaced != force {
+				t.Fatalf("expected the imported value to be replaced to be %t, got: %q", force, client.secrets[secret.ID].Value)
+			}
+			if imported, _ := updateResp.Private.GetKey(context.Background(), importedPrivateKey); imported != nil {
+				t.Fatal("expected the import marker to be cleared by the update")
+			}
+		})
+	}
+}
+
+func TestSecretResourceImportMarkerExpires(t *testing.T) {
+	schema := secretResourceTestSchema(t)
+	client := newMockBitwardenClient()
+	secret := client.addSecret("key", "remote", "", mockOrgId, validProjectUUID)
+	r := &secretResource{bitwardenClient: client, organizationId: mockOrgId}
+
+	importResp := fwresource.ImportStateResponse{State: newTestState(t, schema, secretResourceModel{})}
+	newTestPrivateState(&importResp.Private)
+	r.ImportState(context.Background(), fwresource.ImportStateRequest{ID: secret.ID}, &importResp)
+	if importResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", importResp.Diagnostics)
+	}
+
+	// The read of the import and the refresh of the plan following terraform import keep the mark.
+	state, private := importResp.State, importResp.Private
+	refresh := func() {
+		t.Helper()
+		readResp := fwresource.ReadResponse{State: state, Private: private}
+		r.Read(context.Background(), fwresource.ReadRequest{State: state, Private: private}, &readResp)
+		if readResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %v", readResp.Diagnostics)
+		}
+		state, private = readResp.State, readResp.Private
+	}
+	for range maxImportRefreshes {
+		refresh()
+		if imported, _ := private.GetKey(context.Background(), importedPrivateKey); imported == nil {
+			t.Fatal("expected the import mark to be kept")
+		}
+	}
+
+	// The apply without changes did not replace the value, so force_new_value_on_import set later has no effect.
+	refresh()
+	var stateModel secretResourceModel
+	state.Get(context.Background(), &stateModel)
+	configModel := stateModel
+	configModel.Value = types.StringNull()
+	configModel.ForceNewValueOnImport = types.BoolValue(true)
+	plan := newTestPlan(t, schema, configModel)
+	planResp := fwresource.ModifyPlanResponse{Plan: plan, Private: private}
+	r.ModifyPlan(context.Background(), fwresource.ModifyPlanRequest{
+		Config:  tfsdk.Config{Schema: schema, Raw: plan.Raw},
+		Plan:    plan,
+		State:   state,
+		Private: private,
+	}, &planResp)
+	if planResp.Diagnostics.HasError() || diagnosticsContain(planResp.Diagnostics, "Imported Secret Value Will Be Replaced") {
+		t.Fatalf("expected the value not to be replaced, got: %v", planResp.Diagnostics)
+	}
+	var planned secretResourceModel
+	planResp.Plan.Get(context.Background(), &planned)
+	if planned.Value.IsUnknown() {
+		t.Fatal("expected the value not to be planned as unknown")
+	}
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// rotationNotePrefix starts the last line of the note of a secret with a rotation_interval_days. Bitwarden Secrets
+// Manager has no metadata fields, so the rotation schedule is stored in the note, where external rotators can read it.
+const rotationNotePrefix = "rotation-interval-days: "
+
+var rotationNoteLine = regexp.MustCompile(`(?:^|\n)` + regexp.QuoteMeta(rotationNotePrefix) + `([1-9][0-9]*)$`)
+
+// withRotationInterval appends the rotation interval to the note as its last line. A null interval leaves the note
+// unchanged.
+func withRotationInterval(note string, intervalDays types.Int64) string {
+	if intervalDays.IsNull() || intervalDays.IsUnknown() {
+		return note
+	}
+	line := rotationNotePrefix + strconv.FormatInt(intervalDays.ValueInt64(), 10)
+	if note == "" {
+		return line
+	}
+	return note + "\n" + line
+}
+
+// splitRotationInterval removes the rotation interval line which was added by withRotationInterval from the note and
+// returns the interval, or null if the note has no rotation interval.
+func splitRotationInterval(note string) (string, types.Int64) {
+	match := rotationNoteLine.FindStringSubmatchIndex(note)
+	if match == nil {
+		return note, types.Int64Null()
+	}
+	intervalDays, err := strconv.ParseInt(note[match[2]:match[3]], 10, 64)
+	if err != nil {
+		return note, types.Int64Null()
+	}
+	return note[:match[0]], types.Int64Value(intervalDays)
+}
+
+// nextRotationAt returns the time at which a secret last revised at revisionDate is due for rotation, or null if the
+// secret has no rotation interval.
+func nextRotationAt(revisionDate time.Time, intervalDays types.Int64) types.String {
+	if intervalDays.IsNull() || intervalDays.IsUnknown() {
+		return types.StringNull()
+	}
+	return timestampValue(revisionDate.AddDate(0, 0, int(intervalDays.ValueInt64())))
+}
+
+// warnRotationOverdue warns if the planned next_rotation_at of a secret has passed. A next_rotation_at which is unknown
+// because the secret is updated by the plan is not warned about.
+func warnRotationOverdue(ctx context.Context, plan secretResourceModel, diags *diag.Diagnostics) {
+	if plan.NextRotationAt.IsNull() || plan.NextRotationAt.IsUnknown() {
+		return
+	}
+	dueAt, err := parseTimestampValue(plan.NextRotationAt.ValueString())
+	if err != nil {
+		tflog.SubsystemDebug(ctx, logSubsystem, "Unable to parse next_rotation_at", map[string]any{"error": err.Error()})
+		return
+	}
+	if time.Now().Before(dueAt) {
+		return
+	}
+
+	tflog.SubsystemWarn(ctx, logSubsystem, "Secret rotation overdue", map[string]any{"id": plan.ID.ValueString()})
+	diags.AddAttributeWarning(
+		path.Root("next_rotation_at"),
+		"Secret Rotation Overdue",
+		fmt.Sprintf("The secret with id: %s was due for rotation at %s, %d days after its last revision. "+
+			"Rotate its value, e.g. by changing the value or the generator configuration.",
+			plan.ID.ValueString(), plan.NextRotationAt.ValueString(), plan.RotationIntervalDays.ValueInt64()),
+	)
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"testing"
+	"time"
+)
+
+func TestSplitRotationInterval(t *testing.T) {
+	tests := map[string]struct {
+		note         string
+		expectedNote string
+		expected     types.Int64
+	}{
+		"no interval":          {note: "rotated by hand", expectedNote: "rotated by hand", expected: types.Int64Null()},
+		"interval only":        {note: "rotation-interval-days: 30", expectedNote: "", expected: types.Int64Value(30)},
+		"interval after note":  {note: "line\n\nrotation-interval-days: 7", expectedNote: "line\n", expected: types.Int64Value(7)},
+		"not the last line":    {note: "rotation-interval-days: 7\nline", expectedNote: "rotation-interval-days: 7\nline", expected: types.Int64Null()},
+		"invalid interval":     {note: "rotation-interval-days: 07", expectedNote: "rotation-interval-days: 07", expected: types.Int64Null()},
+		"part of another line": {note: "see rotation-interval-days: 7", expectedNote: "see rotation-interval-days: 7", expected: types.Int64Null()},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			note, interval := splitRotationInterval(test.note)
+			if note != test.expectedNote || !interval.Equal(test.expected) {
+				t.Fatalf("expected %q and %v, got: %q and %v", test.expectedNote, test.expected, note, interval)
+			}
+			if test.expected.IsNull() {
+				return
+			}
+			if joined := withRotationInterval(note, interval); joined != test.note {
+				t.Fatalf("expected the interval to round-trip to %q, got: %q", test.note, joined)
+			}
+		})
+	}
+}
+
+func TestSecretResourceRotationInterval(t *testing.T) {
+	client := newMockBitwardenClient()
+	r := &secretResource{bitwardenClient: client, organizationId: mockOrgId}
+	schema := secretResourceTestSchema(t)
+
+	createResp := fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	newTestPrivateState(&createResp.Private)
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: newTestPlan(t, schema, secretResourceModel{
+		ID:                   types.StringUnknown(),
+		Key:                  types.StringValue("key"),
+		Value:                types.StringValue("value"),
+		Note:                 types.StringValue("rotated by the nightly job"),
+		ProjectID:            types.StringValue(validProjectUUID),
+		RotationIntervalDays: types.Int64Value(30),
+		NextRotationAt:       types.StringUnknown(),
+	})}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error creating secret: %v", createResp.Diagnostics)
+	}
+
+	var state secretResourceModel
+	createResp.State.Get(context.Background(), &state)
+	secret := client.secrets[state.ID.ValueString()]
+	if secret.Note != "rotated by the nightly job\nrotation-interval-days: 30" {
+		t.Fatalf("expected the interval to be stored in the note, got: %q", secret.Note)
+	}
+	if state.Note.ValueString() != "rotated by the nightly job" {
+		t.Fatalf("expected the interval not to be part of the note in the state, got: %q", state.Note.ValueString())
+	}
+	if !state.NextRotationAt.Equal(timestampValue(secret.RevisionDate.AddDate(0, 0, 30))) {
+		t.Fatalf("expected next_rotation_at 30 days after the revision, got: %v", state.NextRotationAt)
+	}
+
+	// The secret was last revised longer ago than the interval.
+	secret.RevisionDate = time.Now().UTC().AddDate(0, 0, -31)
+	client.secrets[secret.ID] = secret
+	readResp := fwresource.ReadResponse{State: createResp.State}
+	newTestPrivateState(&readResp.Private)
+	r.Read(context.Background(), fwresource.ReadRequest{State: createResp.State}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error reading secret: %v", readResp.Diagnostics)
+	}
+
+	planResp := fwresource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: schema, Raw: readResp.State.Raw}}
+	r.ModifyPlan(context.Background(), fwresource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: schema, Raw: readResp.State.Raw},
+		Plan:   tfsdk.Plan{Schema: schema, Raw: readResp.State.Raw},
+		State:  readResp.State,
+	}, &planResp)
+	if !diagnosticsContain(planResp.Diagnostics, "Secret Rotation Overdue") {
+		t.Fatalf("expected a warning about the overdue rotation, got: %v", planResp.Diagnostics)
+	}
+
+	// Removing the interval removes it from the note.
+	readResp.State.Get(context.Background(), &state)
+	plan := state
+	plan.RotationIntervalDays = types.Int64Null()
+	plan.NextRotationAt = types.StringUnknown()
+	updateResp := fwresource.UpdateResponse{State: readResp.State}
+	newTestPrivateState(&updateResp.Private)
+	r.Update(context.Background(), fwresource.UpdateRequest{Plan: newTestPlan(t, schema, plan), State: readResp.State}, &updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error updating secret: %v", updateResp.Diagnostics)
+	}
+	updateResp.State.Get(context.Background(), &state)
+	if note := client.secrets[secret.ID].Note; note != "rotated by the nightly job" {
+		t.Fatalf("expected the interval to be removed from the note, got: %q", note)
+	}
+	if !state.RotationIntervalDays.IsNull() || !state.NextRotationAt.IsNull() {
+		t.Fatalf("expected no rotation schedule, got: %v and %v", state.RotationIntervalDays, state.NextRotationAt)
+	}
+}
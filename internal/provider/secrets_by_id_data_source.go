@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/bitwarden/sdk-go/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ datasource.DataSource              = &secretsByIdDataSource{}
+	_ datasource.DataSourceWithConfigure = &secretsByIdDataSource{}
+)
+
+func NewSecretsByIdDataSource() datasource.DataSource {
+	return &secretsByIdDataSource{}
+}
+
+// secretsByIdDataSource defines the data source implementation.
+type secretsByIdDataSource struct {
+	bitwardenClient sdk.BitwardenClientInterface
+	organizationId  string
+	verboseErrors   bool
+	logLevel        string
+}
+
+type secretsByIdDataSourceModel struct {
+	IDs     []types.String                       `tfsdk:"ids"`
+	Secrets map[string]secretByIdDataSourceModel `tfsdk:"secrets"`
+	Errors  map[string]string                    `tfsdk:"errors"`
+}
+
+type secretByIdDataSourceModel struct {
+	Key            types.String `tfsdk:"key"`
+	Value          types.String `tfsdk:"value"`
+	Note           types.String `tfsdk:"note"`
+	ProjectID      types.String `tfsdk:"project_id"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+}
+
+func (d *secretsByIdDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secrets_by_id"
+}
+
+func (d *secretsByIdDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The secrets_by_id data source reads a list of secrets by their IDs, independent of their projects. " +
+			"Secrets which cannot be read are reported in errors instead of failing the data source.",
+		MarkdownDescription: "The `secrets_by_id` data source reads a list of secrets by their `IDs`, independent of their projects. " +
+			"Secrets which cannot be read are reported in `errors` instead of failing the data source.",
+		Attributes: map[string]schema.Attribute{
+			"ids": schema.ListAttribute{
+				Description:         "List of the IDs of the secrets to read.",
+				MarkdownDescription: "List of the `IDs` of the secrets to read.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringUUIDValidate()),
+				},
+			},
+			"secrets": schema.MapNestedAttribute{
+				Description:         "Map of the secrets which were read, keyed by the ID of the secret.",
+				MarkdownDescription: "Map of the secrets which were read, keyed by the `ID` of the secret.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Description:         "String representation of the key of the secret. Inside Bitwarden Secrets Manager this is called \"name\".",
+							MarkdownDescription: "String representation of the `key` of the secret. Inside Bitwarden Secrets Manager this is called \"name\".",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							Description:         "String representation of the value of the secret. This attribute is sensitive.",
+							MarkdownDescription: "String representation of the `value` of the secret. This attribute is sensitive.",
+							Computed:            true,
+							Sensitive:           true,
+						},
+						"note": schema.StringAttribute{
+							Description:         "String representation of the note of the secret.",
+							MarkdownDescription: "String representation of the `note` of the secret.",
+							Computed:            true,
+						},
+						"project_id": schema.StringAttribute{
+							Description:         "String representation of the ID of the project to which the secret belongs.",
+							MarkdownDescription: "String representation of the `ID` of the project to which the secret belongs.",
+							Computed:            true,
+						},
+						"organization_id": schema.StringAttribute{
+							Description:         "String representation of the ID of the organization to which the secret belongs.",
+							MarkdownDescription: "String representation of the `ID` of the organization to which the secret belongs.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"errors": schema.MapAttribute{
+				Description: "Map of the errors of the secrets which could not be read, keyed by the ID of the secret. " +
+					"A secret whose ID is set in ids is missing in secrets if and only if it is listed here. Null IDs in ids are ignored, because they identify no secret.",
+				MarkdownDescription: "Map of the errors of the secrets which could not be read, keyed by the `ID` of the secret. " +
+					"A secret whose `ID` is set in `ids` is missing in `secrets` if and only if it is listed here. Null `IDs` in `ids` are ignored, because they identify no secret.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *secretsByIdDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	providerDataStruct, ok := configureClient(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	ctx = newLogContext(ctx, providerDataStruct.logLevel)
+	tflog.SubsystemInfo(ctx, logSubsystem, "Configuring Secrets By ID Datasource")
+
+	d.bitwardenClient = providerDataStruct.bitwardenClient
+	d.organizationId = providerDataStruct.organizationId
+	d.verboseErrors = providerDataStruct.verboseErrors
+	d.logLevel = providerDataStruct.logLevel
+
+	tflog.SubsystemInfo(ctx, logSubsystem, "Datasource Configured")
+}
+
+func (d *secretsByIdDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, correlationId := newCorrelationContext(ctx, d.logLevel)
+	defer appendCorrelationId(&resp.Diagnostics, correlationId)
+
+	tflog.SubsystemInfo(ctx, logSubsystem, "Reading Secrets By ID Datasource")
+
+	var state secretsByIdDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer recoverFromPanic(ctx, "Read Secrets By ID", "", &resp.Diagnostics)
+
+	if d.bitwardenClient == nil {
+		resp.Diagnostics.AddError(
+			"Client Not Initialized",
+			"The Bitwarden bitwardenClient was not properly initialized.",
+		)
+		return
+	}
+
+	if !checkContext(ctx, "Read Secrets By ID", &resp.Diagnostics) {
+		return
+	}
+
+	var ids []string
+	for _, id := range state.IDs {
+		// Unknown and null IDs cannot be read and duplicated IDs are only read once.
+		if id.IsNull() || id.IsUnknown() || slices.Contains(ids, id.ValueString()) {
+			continue
+		}
+		ids = append(ids, id.ValueString())
+	}
+
+	secrets, failures := d.readSecrets(ids)
+
+	state.Secrets = make(map[string]secretByIdDataSourceModel, len(secrets))
+	for id, secret := range secrets {
+		projectId := types.StringNull()
+		if secret.ProjectID != nil {
+			projectId = types.StringValue(*secret.ProjectID)
+		}
+		state.Secrets[id] = secretByIdDataSourceModel{
+			Key:            types.StringValue(secret.Key),
+			Value:          types.StringValue(secret.Value),
+			Note:           types.StringValue(secret.Note),
+			ProjectID:      projectId,
+			OrganizationID: types.StringValue(secret.OrganizationID),
+		}
+	}
+
+	state.Errors = make(map[string]string, len(failures))
+	for id, err := range failures {
+		state.Errors[id] = sdkErrorDetail(err, d.organizationId, d.verboseErrors)
+	}
+
+	if len(failures) > 0 {
+		failedIds := make([]string, 0, len(failures))
+		for id := range failures {
+			failedIds = append(failedIds, id)
+		}
+		sort.Strings(failedIds)
+		tflog.SubsystemWarn(ctx, logSubsystem, "Some secrets could not be read", map[string]any{"ids": failedIds})
+		resp.Diagnostics.AddWarning(
+			"Unable to Read Some Secrets",
+			fmt.Sprintf("%d of %d secrets could not be read. Their errors are available in the errors attribute.", len(failures), len(ids)),
+		)
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// readSecrets reads the secrets with the given IDs and returns the read secrets and the errors of the other secrets by
+// ID. All secrets are read with one bulk request, which fails as a whole if any secret cannot be read, in which case
+// the secrets are read one by one to tell which secrets failed.
+func (d *secretsByIdDataSource) readSecrets(ids []string) (map[string]sdk.SecretResponse, map[string]error) {
+	secrets := make(map[string]sdk.SecretResponse, len(ids))
+	failures := map[string]error{}
+	if len(ids) == 0 {
+		return secrets, failures
+	}
+
+	response, err := d.bitwardenClient.Secrets().GetByIDS(ids)
+	if err == nil && response != nil && len(response.Data) == len(ids) {
+		for _, secret := range response.Data {
+			secrets[secret.ID] = secret
+		}
+		return secrets, failures
+	}
+
+	for _, id := range ids {
+		secret, err := readSecret(d.bitwardenClient, id)
+		if err != nil {
+			failures[id] = err
+			continue
+		}
+		secrets[id] = *secret
+	}
+	return secrets, failures
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"testing"
+)
+
+func TestSecretsByIdDataSourceRead(t *testing.T) {
+	const missingId = "0f0e8c1d-6a4b-4c2d-9e3f-5a6b7c8d9e0f"
+	client := newMockBitwardenClient()
+	project := client.addProject(mockOrgId, "app")
+	otherProject := client.addProject(mockOrgId, "other")
+	apiKey := client.addSecret("API_KEY", "abc123", "rotated yearly", mockOrgId, project.ID)
+	dbPassword := client.addSecret("DB_PASSWORD", "p@ss word", "", mockOrgId, otherProject.ID)
+
+	d := &secretsByIdDataSource{bitwardenClient: client, organizationId: mockOrgId}
+	schema := dataSourceTestSchema(t, d)
+	read := func(ids ...string) (datasource.ReadResponse, secretsByIdDataSourceModel) {
+		config := secretsByIdDataSourceModel{}
+		for _, id := range ids {
+			config.IDs = append(config.IDs, types.StringValue(id))
+		}
+		resp := datasource.ReadResponse{State: tfsdk.State{Schema: schema}}
+		d.Read(context.Background(), datasource.ReadRequest{Config: newTestConfig(t, schema, config)}, &resp)
+
+		var state secretsByIdDataSourceModel
+		resp.State.Get(context.Background(), &state)
+		return resp, state
+	}
+
+	resp, state := read(apiKey.ID, dbPassword.ID, apiKey.ID)
+	if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() > 0 {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if len(state.Secrets) != 2 || len(state.Errors) != 0 {
+		t.Fatalf("expected 2 secrets and no errors, got: %v, %v", state.Secrets, state.Errors)
+	}
+	secret := state.Secrets[apiKey.ID]
+	if secret.Key.ValueString() != "API_KEY" || secret.Value.ValueString() != "abc123" || secret.Note.ValueString() != "rotated yearly" || secret.ProjectID.ValueString() != project.ID {
+		t.Errorf("unexpected secret API_KEY: %v", secret)
+	}
+	if state.Secrets[dbPassword.ID].ProjectID.ValueString() != otherProject.ID {
+		t.Errorf("expected DB_PASSWORD to belong to %s, got: %v", otherProject.ID, state.Secrets[dbPassword.ID])
+	}
+	if calls := client.callCount("Secrets.Get"); calls != 0 {
+		t.Errorf("expected a single bulk read, got %d single reads", calls)
+	}
+
+	resp, state = read(apiKey.ID, missingId)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("expected a missing secret not to fail the data source, got: %v", resp.Diagnostics)
+	}
+	if !diagnosticsContain(resp.Diagnostics, "Unable to Read Some Secrets") {
+		t.Errorf("expected a warning about the missing secret, got: %v", resp.Diagnostics)
+	}
+	if _, ok := state.Secrets[apiKey.ID]; !ok || len(state.Secrets) != 1 {
+		t.Errorf("expected only API_KEY to be read, got: %v", state.Secrets)
+	}
+	if _, ok := state.Errors[missingId]; !ok || len(state.Errors) != 1 {
+		t.Errorf("expected an error for the missing secret, got: %v", state.Errors)
+	}
+
+	resp, state = read()
+	if resp.Diagnostics.HasError() || len(state.Secrets) != 0 || len(state.Errors) != 0 {
+		t.Fatalf("expected an empty result, got: %v, %v, %v", resp.Diagnostics, state.Secrets, state.Errors)
+	}
+
+	// Null IDs identify no secret and are neither read nor reported.
+	resp = datasource.ReadResponse{State: tfsdk.State{Schema: schema}}
+	d.Read(context.Background(), datasource.ReadRequest{Config: newTestConfig(t, schema, secretsByIdDataSourceModel{
+		IDs: []types.String{types.StringValue(apiKey.ID), types.StringNull()},
+	})}, &resp)
+	resp.State.Get(context.Background(), &state)
+	if resp.Diagnostics.HasError() || len(state.Secrets) != 1 || len(state.Errors) != 0 {
+		t.Fatalf("expected null IDs to be ignored, got: %v, %v, %v", resp.Diagnostics, state.Secrets, state.Errors)
+	}
+}
@@ -0,0 +1,226 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/bitwarden/sdk-go/v2"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ datasource.DataSource              = &secretsDiffDataSource{}
+	_ datasource.DataSourceWithConfigure = &secretsDiffDataSource{}
+)
+
+func NewSecretsDiffDataSource() datasource.DataSource {
+	return &secretsDiffDataSource{}
+}
+
+// secretsDiffDataSource defines the data source implementation.
+type secretsDiffDataSource struct {
+	bitwardenClient sdk.BitwardenClientInterface
+	projectCache    *projectCache
+	organizationId  string
+	verboseErrors   bool
+	logLevel        string
+	redactKeys      bool
+}
+
+type secretsDiffDataSourceModel struct {
+	ProjectIDA      types.String `tfsdk:"project_id_a"`
+	ProjectIDB      types.String `tfsdk:"project_id_b"`
+	OrganizationID  types.String `tfsdk:"organization_id"`
+	OnlyInA         []string     `tfsdk:"only_in_a"`
+	OnlyInB         []string     `tfsdk:"only_in_b"`
+	DifferingValues []string     `tfsdk:"differing_values"`
+}
+
+func (s *secretsDiffDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secrets_diff"
+}
+
+func (s *secretsDiffDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The secrets_diff data source compares the secrets of two projects by their keys. " +
+			"Values are compared by their SHA-256 hash and never appear in the output.",
+		MarkdownDescription: "The `secrets_diff` data source compares the secrets of two projects by their keys. " +
+			"Values are compared by their SHA-256 hash and never appear in the output.",
+		Attributes: map[string]schema.Attribute{
+			"project_id_a": schema.StringAttribute{
+				Description:         "String representation of the ID of the first project to compare.",
+				MarkdownDescription: "String representation of the `ID` of the first project to compare.",
+				Required:            true,
+				Validators: []validator.String{
+					stringUUIDValidate(),
+				},
+			},
+			"project_id_b": schema.StringAttribute{
+				Description:         "String representation of the ID of the second project to compare.",
+				MarkdownDescription: "String representation of the `ID` of the second project to compare.",
+				Required:            true,
+				Validators: []validator.String{
+					stringUUIDValidate(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description:         "String representation of the ID of the organization to which both projects belong. Overrides the organization configured on the provider.",
+				MarkdownDescription: "String representation of the `ID` of the organization to which both projects belong. Overrides the `organization_id` configured on the provider.",
+				Computed:            true,
+				Optional:            true,
+				Validators: []validator.String{
+					stringUUIDValidate(),
+				},
+			},
+			"only_in_a": schema.ListAttribute{
+				Description: "Sorted list of secret keys which only exist in the first project.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"only_in_b": schema.ListAttribute{
+				Description: "Sorted list of secret keys which only exist in the second project.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"differing_values": schema.ListAttribute{
+				Description: "Sorted list of secret keys which exist in both projects but whose values differ.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (s *secretsDiffDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	providerDataStruct, ok := configureClient(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	ctx = newLogContext(ctx, providerDataStruct.logLevel)
+	tflog.SubsystemInfo(ctx, logSubsystem, "Configuring Secrets Diff Datasource")
+
+	s.bitwardenClient = providerDataStruct.bitwardenClient
+	s.projectCache = providerDataStruct.projectCache
+	s.organizationId = providerDataStruct.organizationId
+	s.verboseErrors = providerDataStruct.verboseErrors
+	s.logLevel = providerDataStruct.logLevel
+	s.redactKeys = providerDataStruct.redactKeys
+
+	tflog.SubsystemInfo(ctx, logSubsystem, "Datasource Configured")
+}
+
+func (s *secretsDiffDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, correlationId := newCorrelationContext(ctx, s.logLevel)
+	defer appendCorrelationId(&resp.Diagnostics, correlationId)
+
+	tflog.SubsystemInfo(ctx, logSubsystem, "Reading Secrets Diff Datasource")
+
+	var state secretsDiffDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationId := resolveOrganizationId(state.OrganizationID, s.organizationId)
+	defer recoverFromPanic(ctx, "Compare Secrets", organizationId, &resp.Diagnostics)
+
+	if s.bitwardenClient == nil {
+		resp.Diagnostics.AddError(
+			"Client Not Initialized",
+			"The Bitwarden bitwardenClient was not properly initialized.",
+		)
+		return
+	}
+
+	if !checkContext(ctx, "Compare Secrets", &resp.Diagnostics) {
+		return
+	}
+
+	hashesA, ok := s.readValueHashes(ctx, organizationId, state.ProjectIDA.ValueString(), resp)
+	if !ok {
+		return
+	}
+
+	hashesB, ok := s.readValueHashes(ctx, organizationId, state.ProjectIDB.ValueString(), resp)
+	if !ok {
+		return
+	}
+
+	state.OrganizationID = types.StringValue(organizationId)
+	state.OnlyInA = []string{}
+	state.OnlyInB = []string{}
+	state.DifferingValues = []string{}
+
+	for key, hashA := range hashesA {
+		hashB, found := hashesB[key]
+		if !found {
+			state.OnlyInA = append(state.OnlyInA, key)
+		} else if hashA != hashB {
+			state.DifferingValues = append(state.DifferingValues, key)
+		}
+	}
+
+	for key := range hashesB {
+		if _, found := hashesA[key]; !found {
+			state.OnlyInB = append(state.OnlyInB, key)
+		}
+	}
+
+	sort.Strings(state.OnlyInA)
+	sort.Strings(state.OnlyInB)
+	sort.Strings(state.DifferingValues)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// readValueHashes returns the hashed values of all secrets of a project by key. Keys are not unique inside Bitwarden
+// Secrets Manager, so the hashes of duplicated keys are combined and a warning is added.
+func (s *secretsDiffDataSource) readValueHashes(ctx context.Context, organizationId string, projectId string, resp *datasource.ReadResponse) (map[string]string, bool) {
+	secrets, err := listProjectSecrets(s.bitwardenClient, s.projectCache, organizationId, projectId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Project Secrets",
+			fmt.Sprintf("Unable to read the secrets of the project with id: %s.\n\n%s%s", projectId, sdkErrorDetail(err, organizationId, s.verboseErrors),
+				projectScopeDetail(s.bitwardenClient, s.projectCache, organizationId, projectId, err)),
+		)
+		return nil, false
+	}
+
+	hashesByKey := map[string][]string{}
+	for _, secret := range secrets {
+		hashesByKey[secret.Key] = append(hashesByKey[secret.Key], hashSecretValue(secret.Value))
+	}
+
+	hashes := make(map[string]string, len(hashesByKey))
+	for key, keyHashes := range hashesByKey {
+		if len(keyHashes) > 1 {
+			tflog.SubsystemWarn(ctx, logSubsystem, "Project contains duplicated secret keys", map[string]any{
+				"project_id": projectId,
+				"key":        displaySecretKey(key, s.redactKeys),
+			})
+			resp.Diagnostics.AddWarning(
+				"Duplicated Secret Key",
+				fmt.Sprintf("The project with id: %s contains %d secrets with the key \"%s\". "+
+					"They are compared as one entry.", projectId, len(keyHashes), displaySecretKey(key, s.redactKeys)),
+			)
+		}
+		slices.Sort(keyHashes)
+		hashes[key] = strings.Join(keyHashes, ",")
+	}
+
+	return hashes, true
+}
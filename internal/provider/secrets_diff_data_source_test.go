@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSecretsDiffDataSourceRead(t *testing.T) {
+	client := newMockBitwardenClient()
+	projectA := client.addProject(mockOrgId, "staging")
+	projectB := client.addProject(mockOrgId, "production")
+	client.addSecret("SAME", "value", "", mockOrgId, projectA.ID)
+	client.addSecret("SAME", "value", "", mockOrgId, projectB.ID)
+	client.addSecret("CHANGED", "staging-value", "", mockOrgId, projectA.ID)
+	client.addSecret("CHANGED", "production-value", "", mockOrgId, projectB.ID)
+	client.addSecret("NEW", "staging-only", "", mockOrgId, projectA.ID)
+	client.addSecret("OLD", "production-only", "", mockOrgId, projectB.ID)
+
+	d := &secretsDiffDataSource{bitwardenClient: client, organizationId: mockOrgId}
+	schema := dataSourceTestSchema(t, d)
+	req := datasource.ReadRequest{Config: newTestConfig(t, schema, secretsDiffDataSourceModel{
+		ProjectIDA:     types.StringValue(projectA.ID),
+		ProjectIDB:     types.StringValue(projectB.ID),
+		OrganizationID: types.StringNull(),
+	})}
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: schema}}
+	d.Read(context.Background(), req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var state secretsDiffDataSourceModel
+	resp.State.Get(context.Background(), &state)
+	if !reflect.DeepEqual(state.OnlyInA, []string{"NEW"}) {
+		t.Errorf("unexpected only_in_a: %v", state.OnlyInA)
+	}
+	if !reflect.DeepEqual(state.OnlyInB, []string{"OLD"}) {
+		t.Errorf("unexpected only_in_b: %v", state.OnlyInB)
+	}
+	if !reflect.DeepEqual(state.DifferingValues, []string{"CHANGED"}) {
+		t.Errorf("unexpected differing_values: %v", state.DifferingValues)
+	}
+
+	raw := resp.State.Raw.String()
+	for _, value := range []string{"staging-value", "production-value", "staging-only", "production-only"} {
+		if strings.Contains(raw, value) {
+			t.Errorf("secret value %q leaked into state", value)
+		}
+	}
+}
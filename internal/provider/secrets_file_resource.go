@@ -0,0 +1,393 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+
+	"github.com/bitwarden/sdk-go/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource               = &secretsFileResource{}
+	_ resource.ResourceWithConfigure  = &secretsFileResource{}
+	_ resource.ResourceWithModifyPlan = &secretsFileResource{}
+)
+
+func NewSecretsFileResource() resource.Resource {
+	return &secretsFileResource{}
+}
+
+// secretsFileResource defines the resource implementation. It renders all secrets of a project into a local file, which
+// only exists on the machine running Terraform. The rendered content is tracked by its hash and never logged.
+type secretsFileResource struct {
+	bitwardenClient sdk.BitwardenClientInterface
+	projectCache    *projectCache
+	summary         *operationSummary
+	organizationId  string
+	verboseErrors   bool
+	logLevel        string
+	redactKeys      bool
+}
+
+type secretsFileResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	ProjectID      types.String `tfsdk:"project_id"`
+	Path           types.String `tfsdk:"path"`
+	Format         types.String `tfsdk:"format"`
+	FilePermission types.String `tfsdk:"file_permission"`
+	ContentSha256  types.String `tfsdk:"content_sha256"`
+}
+
+func (r *secretsFileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secrets_file"
+}
+
+func (r *secretsFileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The secrets_file resource writes all secrets of a project to a local file on the machine running Terraform, e.g. to bootstrap virtual machines. " +
+			"The file is rewritten when the secrets change and deleted on destroy. Its content is never logged.",
+		MarkdownDescription: "The `secrets_file` resource writes all secrets of a project to a local file on the machine running Terraform, e.g. to bootstrap virtual machines. " +
+			"The file is rewritten when the secrets change and deleted on destroy. Its content is never logged.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "String representation of the path of the file.",
+				MarkdownDescription: "String representation of the `path` of the file.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description:         "String representation of the ID of the project whose secrets are written to the file.",
+				MarkdownDescription: "String representation of the `ID` of the project whose secrets are written to the file.",
+				Required:            true,
+				Validators: []validator.String{
+					stringUUIDValidate(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Description:         "The path of the file. Missing parent directories are created. Changing the path deletes the old file.",
+				MarkdownDescription: "The path of the file. Missing parent directories are created. Changing the `path` deletes the old file.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"format": schema.StringAttribute{
+				Description: "The format of the file, one of env for KEY=value lines, json for a JSON object or yaml for a YAML mapping. " +
+					"Secrets are sorted by key, and the most recently revised secret is used for duplicated keys. The provided default is env.",
+				MarkdownDescription: "The format of the file, one of `env` for `KEY=value` lines, `json` for a JSON object or `yaml` for a YAML mapping. " +
+					"Secrets are sorted by key, and the most recently revised secret is used for duplicated keys. The provided default is `env`.",
+				Computed: true,
+				Optional: true,
+				Default:  stringdefault.StaticString("env"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("env", "json", "yaml"),
+				},
+			},
+			"file_permission": schema.StringAttribute{
+				Description:         "The permissions of the file as an octal number. The provided default is 0600, which only allows the owner to read and write the file.",
+				MarkdownDescription: "The permissions of the file as an octal number. The provided default is `0600`, which only allows the owner to read and write the file.",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString("0600"),
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^0?[0-7]{3}$`), "must be an octal file permission, e.g. 0600"),
+				},
+			},
+			"content_sha256": schema.StringAttribute{
+				Description: "The hex encoded SHA-256 hash of the content of the file. It changes if the secrets of the project change, " +
+					"which is detected during the plan, or if the file was modified outside of Terraform.",
+				MarkdownDescription: "The hex encoded SHA-256 hash of the content of the file. It changes if the secrets of the project change, " +
+					"which is detected during the plan, or if the file was modified outside of Terraform.",
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *secretsFileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	providerDataStruct, ok := configureClient(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	ctx = newLogContext(ctx, providerDataStruct.logLevel)
+	tflog.SubsystemInfo(ctx, logSubsystem, "Configuring Secrets File Resource")
+
+	r.bitwardenClient = providerDataStruct.bitwardenClient
+	r.projectCache = providerDataStruct.projectCache
+	r.summary = providerDataStruct.summary
+	r.organizationId = providerDataStruct.organizationId
+	r.verboseErrors = providerDataStruct.verboseErrors
+	r.logLevel = providerDataStruct.logLevel
+	r.redactKeys = providerDataStruct.redactKeys
+
+	tflog.SubsystemInfo(ctx, logSubsystem, "Resource Configured")
+}
+
+func (r *secretsFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, correlationId := newCorrelationContext(ctx, r.logLevel)
+	defer appendCorrelationId(&resp.Diagnostics, correlationId)
+	defer r.summary.recordIfSucceeded(ctx, "create", &resp.Diagnostics)
+
+	var plan secretsFileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer recoverFromPanic(ctx, "Create Secrets File", plan.Path.ValueString(), &resp.Diagnostics)
+
+	if !r.writeFile(ctx, &plan, &resp.Diagnostics) {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read removes the resource if the file was deleted outside of Terraform and tracks the hash of the file on disk, so that
+// modified files are rewritten by the next apply. The secrets of the project are compared during the plan.
+func (r *secretsFileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, correlationId := newCorrelationContext(ctx, r.logLevel)
+	defer appendCorrelationId(&resp.Diagnostics, correlationId)
+
+	var state secretsFileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content, err := os.ReadFile(state.Path.ValueString())
+	if errors.Is(err, fs.ErrNotExist) {
+		tflog.SubsystemWarn(ctx, logSubsystem, "Secrets file not found, removing it from state", map[string]any{"path": state.Path.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Secrets File",
+			fmt.Sprintf("Unable to read the secrets file %s: %s", state.Path.ValueString(), err),
+		)
+		return
+	}
+
+	state.ContentSha256 = types.StringValue(hashSecretValue(string(content)))
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *secretsFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, correlationId := newCorrelationContext(ctx, r.logLevel)
+	defer appendCorrelationId(&resp.Diagnostics, correlationId)
+	defer r.summary.recordIfSucceeded(ctx, "update", &resp.Diagnostics)
+
+	var plan secretsFileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer recoverFromPanic(ctx, "Update Secrets File", plan.Path.ValueString(), &resp.Diagnostics)
+
+	if !r.writeFile(ctx, &plan, &resp.Diagnostics) {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *secretsFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, correlationId := newCorrelationContext(ctx, r.logLevel)
+	defer appendCorrelationId(&resp.Diagnostics, correlationId)
+	defer r.summary.recordIfSucceeded(ctx, "delete", &resp.Diagnostics)
+
+	var state secretsFileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := os.Remove(state.Path.ValueString())
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Secrets File",
+			fmt.Sprintf("Unable to delete the secrets file %s: %s", state.Path.ValueString(), err),
+		)
+		return
+	}
+
+	tflog.SubsystemInfo(ctx, logSubsystem, "Deleted secrets file", map[string]any{"path": state.Path.ValueString()})
+}
+
+// ModifyPlan renders the secrets of the project and plans the file to be rewritten if its content would change.
+// Terraform plans unconfigured computed attributes as unknown only on other changes, so changed secrets would
+// otherwise go unnoticed.
+func (r *secretsFileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ctx, correlationId := newCorrelationContext(ctx, r.logLevel)
+	defer appendCorrelationId(&resp.Diagnostics, correlationId)
+
+	// Nothing to do on create and destroy, or before the provider is configured.
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() || r.bitwardenClient == nil {
+		return
+	}
+
+	var plan, state secretsFileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.ProjectID.IsUnknown() || plan.Format.IsUnknown() {
+		return
+	}
+
+	content, ok := r.renderFile(plan, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	// The hash is only kept if the file is not written, because the secrets may change again until it is written.
+	contentSha256 := types.StringValue(hashSecretValue(content))
+	if !contentSha256.Equal(state.ContentSha256) {
+		tflog.SubsystemDebug(ctx, logSubsystem, "Secrets file content changed", map[string]any{"path": state.Path.ValueString()})
+		contentSha256 = types.StringUnknown()
+	} else if !plan.ProjectID.Equal(state.ProjectID) || !plan.Format.Equal(state.Format) || !plan.FilePermission.Equal(state.FilePermission) {
+		contentSha256 = types.StringUnknown()
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_sha256"), contentSha256)...)
+}
+
+// renderFile renders the secrets of the project in the format of the file.
+func (r *secretsFileResource) renderFile(plan secretsFileResourceModel, diags *diag.Diagnostics) (string, bool) {
+	secrets, err := listProjectSecrets(r.bitwardenClient, r.projectCache, r.organizationId, plan.ProjectID.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Unable to Read Project Secrets",
+			fmt.Sprintf("Unable to read the secrets of the project with id: %s.\n\n%s%s", plan.ProjectID.ValueString(), sdkErrorDetail(err, r.organizationId, r.verboseErrors),
+				projectScopeDetail(r.bitwardenClient, r.projectCache, r.organizationId, plan.ProjectID.ValueString(), err)),
+		)
+		return "", false
+	}
+
+	values, duplicates := secretValuesByKey(secrets, false)
+	addDuplicatedKeysWarning(diags, plan.ProjectID.ValueString(), duplicates, r.redactKeys)
+
+	content, err := renderSecretsFile(values, plan.Format.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Unable to Render Secrets File",
+			fmt.Sprintf("Unable to render the secrets of the project with id: %s as %s: %s", plan.ProjectID.ValueString(), plan.Format.ValueString(), err),
+		)
+		return "", false
+	}
+	return content, true
+}
+
+// writeFile renders the secrets of the project and replaces the file atomically, so that the file never contains
+// partial content or has broader permissions than configured. It sets the computed attributes of the plan.
+func (r *secretsFileResource) writeFile(ctx context.Context, plan *secretsFileResourceModel, diags *diag.Diagnostics) bool {
+	if r.bitwardenClient == nil {
+		diags.AddError(
+			"Client Not Initialized",
+			"The Bitwarden client was not properly initialized.",
+		)
+		return false
+	}
+
+	if !checkContext(ctx, "Write Secrets File", diags) {
+		return false
+	}
+
+	content, ok := r.renderFile(*plan, diags)
+	if !ok {
+		return false
+	}
+
+	permission, err := strconv.ParseUint(plan.FilePermission.ValueString(), 8, 32)
+	if err == nil {
+		err = writeFileAtomically(plan.Path.ValueString(), []byte(content), fs.FileMode(permission))
+	}
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("path"),
+			"Unable to Write Secrets File",
+			fmt.Sprintf("Unable to write the secrets file %s: %s", plan.Path.ValueString(), err),
+		)
+		return false
+	}
+
+	plan.ID = plan.Path
+	plan.ContentSha256 = types.StringValue(hashSecretValue(content))
+	tflog.SubsystemInfo(ctx, logSubsystem, "Wrote secrets file", map[string]any{
+		"path":           plan.Path.ValueString(),
+		"format":         plan.Format.ValueString(),
+		"content_sha256": plan.ContentSha256.ValueString(),
+	})
+	return true
+}
+
+// renderSecretsFile renders the given values in the given format of a secrets file.
+func renderSecretsFile(values map[string]string, format string) (string, error) {
+	switch format {
+	case "json":
+		content, err := renderSecretsJson(values, true)
+		return content + "\n", err
+	case "yaml":
+		return renderSecretsYaml(values, "")
+	default:
+		return renderDotenv(values), nil
+	}
+}
+
+// writeFileAtomically writes the content to a temporary file with the given permissions next to the target, which then
+// replaces the target. Missing parent directories are created with permissions restricted to the owner.
+func writeFileAtomically(target string, content []byte, permission fs.FileMode) error {
+	directory := filepath.Dir(target)
+	if err := os.MkdirAll(directory, 0o700); err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(directory, "."+filepath.Base(target)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	// The permissions are set before the content is written, and independent of the umask.
+	if err := file.Chmod(permission); err != nil {
+		file.Close()
+		return err
+	}
+	if _, err := file.Write(content); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), target)
+}
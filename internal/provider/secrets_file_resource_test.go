@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSecretsFileResource(t *testing.T) {
+	client := newMockBitwardenClient()
+	project := client.addProject(mockOrgId, "app")
+	secret := client.addSecret("API_KEY", "abc123", "", mockOrgId, project.ID)
+	r := &secretsFileResource{bitwardenClient: client, organizationId: mockOrgId}
+	schema := resourceTestSchema(t, r)
+	filePath := filepath.Join(t.TempDir(), "config", "app.env")
+
+	plan := secretsFileResourceModel{
+		ID:             types.StringUnknown(),
+		ProjectID:      types.StringValue(project.ID),
+		Path:           types.StringValue(filePath),
+		Format:         types.StringValue("env"),
+		FilePermission: types.StringValue("0600"),
+		ContentSha256:  types.StringUnknown(),
+	}
+	createResp := fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: newTestPlan(t, schema, plan)}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", createResp.Diagnostics)
+	}
+
+	content, err := os.ReadFile(filePath)
+	if err != nil || string(content) != "API_KEY=abc123\n" {
+		t.Fatalf("unexpected file content: %q, %v", content, err)
+	}
+	if info, _ := os.Stat(filePath); info.Mode().Perm() != 0o600 {
+		t.Errorf("expected permissions 0600, got: %v", info.Mode().Perm())
+	}
+
+	var state secretsFileResourceModel
+	createResp.State.Get(context.Background(), &state)
+	if state.ContentSha256.ValueString() != hashSecretValue(string(content)) || state.ID.ValueString() != filePath {
+		t.Fatalf("unexpected state: %+v", state)
+	}
+
+	// Unchanged secrets keep the hash, changed secrets plan a rewrite.
+	modifyPlan := func() types.String {
+		t.Helper()
+		planned := newTestPlan(t, schema, state)
+		resp := fwresource.ModifyPlanResponse{Plan: planned}
+		r.ModifyPlan(context.Background(), fwresource.ModifyPlanRequest{Plan: planned, State: createResp.State}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %v", resp.Diagnostics)
+		}
+		var result secretsFileResourceModel
+		resp.Plan.Get(context.Background(), &result)
+		return result.ContentSha256
+	}
+	if planned := modifyPlan(); !planned.Equal(state.ContentSha256) {
+		t.Errorf("expected the hash to be kept, got: %v", planned)
+	}
+	secret.Value = "def456"
+	client.secrets[secret.ID] = secret
+	if planned := modifyPlan(); !planned.IsUnknown() {
+		t.Errorf("expected the hash to be unknown, got: %v", planned)
+	}
+
+	plan = state
+	plan.Format = types.StringValue("json")
+	plan.FilePermission = types.StringValue("0640")
+	plan.ContentSha256 = types.StringUnknown()
+	updateResp := fwresource.UpdateResponse{State: createResp.State}
+	r.Update(context.Background(), fwresource.UpdateRequest{State: createResp.State, Plan: newTestPlan(t, schema, plan)}, &updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", updateResp.Diagnostics)
+	}
+
+	var values map[string]string
+	content, _ = os.ReadFile(filePath)
+	if err := json.Unmarshal(content, &values); err != nil || values["API_KEY"] != "def456" {
+		t.Fatalf("unexpected file content: %q, %v", content, err)
+	}
+	if info, _ := os.Stat(filePath); info.Mode().Perm() != 0o640 {
+		t.Errorf("expected permissions 0640, got: %v", info.Mode().Perm())
+	}
+
+	// Files modified outside of Terraform are detected by their hash.
+	if err := os.WriteFile(filePath, []byte("modified"), 0o600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	readResp := fwresource.ReadResponse{State: updateResp.State}
+	r.Read(context.Background(), fwresource.ReadRequest{State: updateResp.State}, &readResp)
+	readResp.State.Get(context.Background(), &state)
+	if state.ContentSha256.ValueString() != hashSecretValue("modified") {
+		t.Errorf("expected the hash of the modified file, got: %v", state.ContentSha256)
+	}
+
+	deleteResp := fwresource.DeleteResponse{State: readResp.State}
+	r.Delete(context.Background(), fwresource.DeleteRequest{State: readResp.State}, &deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", deleteResp.Diagnostics)
+	}
+	if _, err := os.Stat(filePath); !os.IsNotExist(err) {
+		t.Errorf("expected the file to be deleted, got: %v", err)
+	}
+
+	// Deleted files are removed from the state and recreated.
+	readResp = fwresource.ReadResponse{State: updateResp.State}
+	r.Read(context.Background(), fwresource.ReadRequest{State: updateResp.State}, &readResp)
+	if !readResp.State.Raw.IsNull() {
+		t.Errorf("expected the resource to be removed from state")
+	}
+}
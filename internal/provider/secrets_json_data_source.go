@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/bitwarden/sdk-go/v2"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ datasource.DataSource              = &secretsJsonDataSource{}
+	_ datasource.DataSourceWithConfigure = &secretsJsonDataSource{}
+)
+
+func NewSecretsJsonDataSource() datasource.DataSource {
+	return &secretsJsonDataSource{}
+}
+
+// secretsJsonDataSource defines the data source implementation.
+type secretsJsonDataSource struct {
+	bitwardenClient sdk.BitwardenClientInterface
+	projectCache    *projectCache
+	organizationId  string
+	verboseErrors   bool
+	logLevel        string
+	redactKeys      bool
+}
+
+type secretsJsonDataSourceModel struct {
+	ProjectID      types.String `tfsdk:"project_id"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	PrettyPrint    types.Bool   `tfsdk:"pretty_print"`
+	Json           types.String `tfsdk:"json"`
+}
+
+func (d *secretsJsonDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secrets_json"
+}
+
+func (d *secretsJsonDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "The secrets_json data source renders all secrets of a project as a JSON object mapping keys to values.",
+		MarkdownDescription: "The `secrets_json` data source renders all secrets of a project as a `JSON` object mapping keys to values.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description:         "String representation of the ID of the project whose secrets are rendered.",
+				MarkdownDescription: "String representation of the `ID` of the project whose secrets are rendered.",
+				Required:            true,
+				Validators: []validator.String{
+					stringUUIDValidate(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description:         "String representation of the ID of the organization to which the project belongs. Overrides the organization configured on the provider.",
+				MarkdownDescription: "String representation of the `ID` of the organization to which the project belongs. Overrides the `organization_id` configured on the provider.",
+				Computed:            true,
+				Optional:            true,
+				Validators: []validator.String{
+					stringUUIDValidate(),
+				},
+			},
+			"pretty_print": schema.BoolAttribute{
+				Description:         "When set to true, the JSON object is indented with two spaces. The provided default is false.",
+				MarkdownDescription: "When set to `true`, the `JSON` object is indented with two spaces. The provided default is `false`.",
+				Optional:            true,
+			},
+			"json": schema.StringAttribute{
+				Description: "The secrets of the project as a JSON object mapping keys to values, sorted by key. Values are always encoded as JSON strings, even if they contain JSON themselves. " +
+					"This attribute is sensitive.",
+				MarkdownDescription: "The secrets of the project as a `JSON` object mapping keys to values, sorted by key. Values are always encoded as `JSON` strings, even if they contain `JSON` themselves. " +
+					"This attribute is sensitive.",
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (d *secretsJsonDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	providerDataStruct, ok := configureClient(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	ctx = newLogContext(ctx, providerDataStruct.logLevel)
+	tflog.SubsystemInfo(ctx, logSubsystem, "Configuring Secrets JSON Datasource")
+
+	d.bitwardenClient = providerDataStruct.bitwardenClient
+	d.projectCache = providerDataStruct.projectCache
+	d.organizationId = providerDataStruct.organizationId
+	d.verboseErrors = providerDataStruct.verboseErrors
+	d.logLevel = providerDataStruct.logLevel
+	d.redactKeys = providerDataStruct.redactKeys
+
+	tflog.SubsystemInfo(ctx, logSubsystem, "Datasource Configured")
+}
+
+func (d *secretsJsonDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, correlationId := newCorrelationContext(ctx, d.logLevel)
+	defer appendCorrelationId(&resp.Diagnostics, correlationId)
+
+	tflog.SubsystemInfo(ctx, logSubsystem, "Reading Secrets JSON Datasource")
+
+	var state secretsJsonDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer recoverFromPanic(ctx, "Render Secrets JSON", state.ProjectID.ValueString(), &resp.Diagnostics)
+
+	if d.bitwardenClient == nil {
+		resp.Diagnostics.AddError(
+			"Client Not Initialized",
+			"The Bitwarden bitwardenClient was not properly initialized.",
+		)
+		return
+	}
+
+	if !checkContext(ctx, "Render Secrets JSON", &resp.Diagnostics) {
+		return
+	}
+
+	organizationId := resolveOrganizationId(state.OrganizationID, d.organizationId)
+	secrets, err := listProjectSecrets(d.bitwardenClient, d.projectCache, organizationId, state.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Project Secrets",
+			fmt.Sprintf("Unable to read the secrets of the project with id: %s.\n\n%s%s", state.ProjectID.ValueString(), sdkErrorDetail(err, organizationId, d.verboseErrors),
+				projectScopeDetail(d.bitwardenClient, d.projectCache, organizationId, state.ProjectID.ValueString(), err)),
+		)
+		return
+	}
+
+	values, duplicates := secretValuesByKey(secrets, false)
+	addDuplicatedKeysWarning(&resp.Diagnostics, state.ProjectID.ValueString(), duplicates, d.redactKeys)
+
+	rendered, err := renderSecretsJson(values, state.PrettyPrint.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Render Secrets as JSON",
+			err.Error(),
+		)
+		return
+	}
+
+	state.OrganizationID = types.StringValue(organizationId)
+	state.Json = types.StringValue(rendered)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// renderSecretsJson renders the given values as a JSON object. HTML characters are not escaped, so values are rendered as is.
+func renderSecretsJson(values map[string]string, prettyPrint bool) (string, error) {
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	if prettyPrint {
+		encoder.SetIndent("", "  ")
+	}
+
+	if err := encoder.Encode(values); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(buffer.String(), "\n"), nil
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"testing"
+)
+
+func TestSecretsJsonDataSourceRead(t *testing.T) {
+	client := newMockBitwardenClient()
+	project := client.addProject(mockOrgId, "app")
+	client.addSecret("CONFIG", `{"nested": "<value>"}`, "", mockOrgId, project.ID)
+	client.addSecret("API_KEY", `abc"123`, "", mockOrgId, project.ID)
+
+	tests := map[string]struct {
+		prettyPrint types.Bool
+		expected    string
+	}{
+		"compact": {
+			prettyPrint: types.BoolNull(),
+			expected:    `{"API_KEY":"abc\"123","CONFIG":"{\"nested\": \"<value>\"}"}`,
+		},
+		"pretty print": {
+			prettyPrint: types.BoolValue(true),
+			expected:    "{\n  \"API_KEY\": \"abc\\\"123\",\n  \"CONFIG\": \"{\\\"nested\\\": \\\"<value>\\\"}\"\n}",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			d := &secretsJsonDataSource{bitwardenClient: client, organizationId: mockOrgId}
+			schema := dataSourceTestSchema(t, d)
+			req := datasource.ReadRequest{Config: newTestConfig(t, schema, secretsJsonDataSourceModel{
+				ProjectID:      types.StringValue(project.ID),
+				OrganizationID: types.StringNull(),
+				PrettyPrint:    test.prettyPrint,
+				Json:           types.StringNull(),
+			})}
+			resp := datasource.ReadResponse{State: tfsdk.State{Schema: schema}}
+			d.Read(context.Background(), req, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var state secretsJsonDataSourceModel
+			resp.State.Get(context.Background(), &state)
+			if state.Json.ValueString() != test.expected {
+				t.Fatalf("expected json %q, got: %q", test.expected, state.Json.ValueString())
+			}
+		})
+	}
+}
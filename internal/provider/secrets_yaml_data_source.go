@@ -0,0 +1,191 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/bitwarden/sdk-go/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ datasource.DataSource              = &secretsYamlDataSource{}
+	_ datasource.DataSourceWithConfigure = &secretsYamlDataSource{}
+)
+
+func NewSecretsYamlDataSource() datasource.DataSource {
+	return &secretsYamlDataSource{}
+}
+
+// secretsYamlDataSource defines the data source implementation.
+type secretsYamlDataSource struct {
+	bitwardenClient sdk.BitwardenClientInterface
+	projectCache    *projectCache
+	organizationId  string
+	verboseErrors   bool
+	logLevel        string
+	redactKeys      bool
+}
+
+type secretsYamlDataSourceModel struct {
+	ProjectID      types.String `tfsdk:"project_id"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	WrapKey        types.String `tfsdk:"wrap_key"`
+	Yaml           types.String `tfsdk:"yaml"`
+}
+
+func (d *secretsYamlDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secrets_yaml"
+}
+
+func (d *secretsYamlDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "The secrets_yaml data source renders all secrets of a project as a YAML document mapping keys to values.",
+		MarkdownDescription: "The `secrets_yaml` data source renders all secrets of a project as a `YAML` document mapping keys to values.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description:         "String representation of the ID of the project whose secrets are rendered.",
+				MarkdownDescription: "String representation of the `ID` of the project whose secrets are rendered.",
+				Required:            true,
+				Validators: []validator.String{
+					stringUUIDValidate(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description:         "String representation of the ID of the organization to which the project belongs. Overrides the organization configured on the provider.",
+				MarkdownDescription: "String representation of the `ID` of the organization to which the project belongs. Overrides the `organization_id` configured on the provider.",
+				Computed:            true,
+				Optional:            true,
+				Validators: []validator.String{
+					stringUUIDValidate(),
+				},
+			},
+			"wrap_key": schema.StringAttribute{
+				Description:         "When set, the secrets are nested below this key, e.g. stringData to render the data of a Kubernetes secret manifest.",
+				MarkdownDescription: "When set, the secrets are nested below this key, e.g. `stringData` to render the data of a Kubernetes secret manifest.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"yaml": schema.StringAttribute{
+				Description: "The secrets of the project as a YAML mapping of keys to values, sorted by key. Values are always rendered as YAML strings. " +
+					"Multiline values are rendered as literal block scalars and values with a special meaning in YAML are quoted. " +
+					"This attribute is sensitive.",
+				MarkdownDescription: "The secrets of the project as a `YAML` mapping of keys to values, sorted by key. Values are always rendered as `YAML` strings. " +
+					"Multiline values are rendered as literal block scalars and values with a special meaning in `YAML` are quoted. " +
+					"This attribute is sensitive.",
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (d *secretsYamlDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	providerDataStruct, ok := configureClient(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	ctx = newLogContext(ctx, providerDataStruct.logLevel)
+	tflog.SubsystemInfo(ctx, logSubsystem, "Configuring Secrets YAML Datasource")
+
+	d.bitwardenClient = providerDataStruct.bitwardenClient
+	d.projectCache = providerDataStruct.projectCache
+	d.organizationId = providerDataStruct.organizationId
+	d.verboseErrors = providerDataStruct.verboseErrors
+	d.logLevel = providerDataStruct.logLevel
+	d.redactKeys = providerDataStruct.redactKeys
+
+	tflog.SubsystemInfo(ctx, logSubsystem, "Datasource Configured")
+}
+
+func (d *secretsYamlDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, correlationId := newCorrelationContext(ctx, d.logLevel)
+	defer appendCorrelationId(&resp.Diagnostics, correlationId)
+
+	tflog.SubsystemInfo(ctx, logSubsystem, "Reading Secrets YAML Datasource")
+
+	var state secretsYamlDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer recoverFromPanic(ctx, "Render Secrets YAML", state.ProjectID.ValueString(), &resp.Diagnostics)
+
+	if d.bitwardenClient == nil {
+		resp.Diagnostics.AddError(
+			"Client Not Initialized",
+			"The Bitwarden bitwardenClient was not properly initialized.",
+		)
+		return
+	}
+
+	if !checkContext(ctx, "Render Secrets YAML", &resp.Diagnostics) {
+		return
+	}
+
+	organizationId := resolveOrganizationId(state.OrganizationID, d.organizationId)
+	secrets, err := listProjectSecrets(d.bitwardenClient, d.projectCache, organizationId, state.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Project Secrets",
+			fmt.Sprintf("Unable to read the secrets of the project with id: %s.\n\n%s%s", state.ProjectID.ValueString(), sdkErrorDetail(err, organizationId, d.verboseErrors),
+				projectScopeDetail(d.bitwardenClient, d.projectCache, organizationId, state.ProjectID.ValueString(), err)),
+		)
+		return
+	}
+
+	values, duplicates := secretValuesByKey(secrets, false)
+	addDuplicatedKeysWarning(&resp.Diagnostics, state.ProjectID.ValueString(), duplicates, d.redactKeys)
+
+	rendered, err := renderSecretsYaml(values, state.WrapKey.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Render Secrets as YAML",
+			err.Error(),
+		)
+		return
+	}
+
+	state.OrganizationID = types.StringValue(organizationId)
+	state.Yaml = types.StringValue(rendered)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// renderSecretsYaml renders the given values as a YAML mapping sorted by key. If wrapKey is not empty, the mapping is
+// nested below wrapKey. Values which YAML would resolve to another type, like "true" or "0755", are quoted.
+func renderSecretsYaml(values map[string]string, wrapKey string) (string, error) {
+	var document any = values
+	if wrapKey != "" {
+		document = map[string]map[string]string{wrapKey: values}
+	}
+
+	var buffer bytes.Buffer
+	encoder := yaml.NewEncoder(&buffer)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(document); err != nil {
+		return "", err
+	}
+	if err := encoder.Close(); err != nil {
+		return "", err
+	}
+
+	return buffer.String(), nil
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"testing"
+)
+
+func TestSecretsYamlDataSourceRead(t *testing.T) {
+	client := newMockBitwardenClient()
+	project := client.addProject(mockOrgId, "app")
+	client.addSecret("CERTIFICATE", "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n", "", mockOrgId, project.ID)
+	client.addSecret("DEBUG", "true", "", mockOrgId, project.ID)
+	client.addSecret("PASSWORD", `a: "b" # c`, "", mockOrgId, project.ID)
+
+	tests := map[string]struct {
+		wrapKey  types.String
+		expected string
+	}{
+		"plain": {
+			wrapKey: types.StringNull(),
+			expected: "CERTIFICATE: |\n" +
+				"  -----BEGIN CERTIFICATE-----\n" +
+				"  MIIB\n" +
+				"  -----END CERTIFICATE-----\n" +
+				"DEBUG: \"true\"\n" +
+				"PASSWORD: 'a: \"b\" # c'\n",
+		},
+		"wrapped": {
+			wrapKey: types.StringValue("stringData"),
+			expected: "stringData:\n" +
+				"  CERTIFICATE: |\n" +
+				"    -----BEGIN CERTIFICATE-----\n" +
+				"    MIIB\n" +
+				"    -----END CERTIFICATE-----\n" +
+				"  DEBUG: \"true\"\n" +
+				"  PASSWORD: 'a: \"b\" # c'\n",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			d := &secretsYamlDataSource{bitwardenClient: client, organizationId: mockOrgId}
+			schema := dataSourceTestSchema(t, d)
+			req := datasource.ReadRequest{Config: newTestConfig(t, schema, secretsYamlDataSourceModel{
+				ProjectID:      types.StringValue(project.ID),
+				OrganizationID: types.StringNull(),
+				WrapKey:        test.wrapKey,
+				Yaml:           types.StringNull(),
+			})}
+			resp := datasource.ReadResponse{State: tfsdk.State{Schema: schema}}
+			d.Read(context.Background(), req, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var state secretsYamlDataSourceModel
+			resp.State.Get(context.Background(), &state)
+			if state.Yaml.ValueString() != test.expected {
+				t.Fatalf("expected yaml %q, got: %q", test.expected, state.Yaml.ValueString())
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// serverVersionTimeout limits how long Configure waits for the server version, so that an unresponsive endpoint only
+// skips the check.
+const serverVersionTimeout = 10 * time.Second
+
+// fetchServerVersion reads the version of the Bitwarden server from the unauthenticated config endpoint of the API. The
+// Bitwarden SDK does not expose the server version, so the endpoint is requested directly.
+func fetchServerVersion(ctx context.Context, apiUrl string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, serverVersionTimeout)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, apiUrl+"/config", nil)
+	if err != nil {
+		return "", err
+	}
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("the config endpoint returned the status %s", response.Status)
+	}
+
+	var config struct {
+		Version string `json:"version"`
+	}
+	if err := json.NewDecoder(response.Body).Decode(&config); err != nil {
+		return "", fmt.Errorf("the config endpoint returned an invalid response: %w", err)
+	}
+	if config.Version == "" {
+		return "", fmt.Errorf("the config endpoint returned no version")
+	}
+	return config.Version, nil
+}
+
+// parseServerVersion parses a dotted numeric version, e.g. 2024.12.0. Pre-release and build suffixes are ignored.
+func parseServerVersion(version string) ([]int, error) {
+	version, _, _ = strings.Cut(strings.TrimPrefix(strings.TrimSpace(version), "v"), "-")
+	version, _, _ = strings.Cut(version, "+")
+
+	var parts []int
+	for _, part := range strings.Split(version, ".") {
+		number, err := strconv.Atoi(part)
+		if err != nil || number < 0 {
+			return nil, fmt.Errorf("%q is not a dotted numeric version", version)
+		}
+		parts = append(parts, number)
+	}
+	return parts, nil
+}
+
+// compareServerVersions returns a negative number if a is lower than b, zero if they are equal and a positive number
+// otherwise. Missing parts are treated as zero, so 2024.12 equals 2024.12.0.
+func compareServerVersions(a []int, b []int) int {
+	for i := 0; i < max(len(a), len(b)); i++ {
+		var partA, partB int
+		if i < len(a) {
+			partA = a[i]
+		}
+		if i < len(b) {
+			partB = b[i]
+		}
+		if partA != partB {
+			return partA - partB
+		}
+	}
+	return 0
+}
+
+// checkServerVersion verifies that the Bitwarden server behind apiUrl has at least the given version. The check is
+// skipped with a warning if the server version cannot be determined.
+func checkServerVersion(ctx context.Context, apiUrl string, minVersion string, diags *diag.Diagnostics) {
+	required, err := parseServerVersion(minVersion)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("min_server_version"),
+			"Invalid Minimum Server Version",
+			fmt.Sprintf("The configured minimum server version is invalid: %s.", err),
+		)
+		return
+	}
+
+	version, err := fetchServerVersion(ctx, apiUrl)
+	var actual []int
+	if err == nil {
+		actual, err = parseServerVersion(version)
+	}
+	if err != nil {
+		tflog.SubsystemWarn(ctx, logSubsystem, "Unable to determine the Bitwarden server version", map[string]any{"error": err.Error()})
+		diags.AddAttributeWarning(
+			path.Root("min_server_version"),
+			"Unable to Determine Server Version",
+			fmt.Sprintf("The version of the Bitwarden server could not be determined, so the minimum server version %s is not verified.\n\n%s", minVersion, err),
+		)
+		return
+	}
+
+	tflog.SubsystemDebug(ctx, logSubsystem, "Determined Bitwarden server version", map[string]any{
+		"server_version":     version,
+		"min_server_version": minVersion,
+	})
+	if compareServerVersions(actual, required) < 0 {
+		diags.AddAttributeError(
+			path.Root("min_server_version"),
+			"Unsupported Bitwarden Server Version",
+			fmt.Sprintf("The Bitwarden server at %s has the version %s, but at least the version %s is required by this configuration. "+
+				"Upgrade the server or lower min_server_version.", apiUrl, version, minVersion),
+		)
+	}
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCompareServerVersions(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{a: "2024.12.0", b: "2024.12.0", expected: 0},
+		{a: "2024.12", b: "2024.12.0", expected: 0},
+		{a: "2024.9.1", b: "2024.12.0", expected: -1},
+		{a: "2025.1.0-beta", b: "2024.12.3", expected: 1},
+		{a: "v2024.12.1", b: "2024.12.0", expected: 1},
+	}
+
+	for _, test := range tests {
+		a, err := parseServerVersion(test.a)
+		if err != nil {
+			t.Fatalf("unexpected error parsing %s: %v", test.a, err)
+		}
+		b, err := parseServerVersion(test.b)
+		if err != nil {
+			t.Fatalf("unexpected error parsing %s: %v", test.b, err)
+		}
+		if result := compareServerVersions(a, b); (result > 0) != (test.expected > 0) || (result < 0) != (test.expected < 0) {
+			t.Errorf("expected comparing %s to %s to be %d, got %d", test.a, test.b, test.expected, result)
+		}
+	}
+
+	if _, err := parseServerVersion("latest"); err == nil {
+		t.Error("expected an error for a non-numeric version")
+	}
+}
+
+func TestCheckServerVersion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/config":
+			w.Write([]byte(`{"object":"config","version":"2024.12.1"}`))
+		case "/invalid/config":
+			w.Write([]byte(`{"object":"config"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	tests := map[string]struct {
+		apiUrl        string
+		minVersion    string
+		expectError   string
+		expectWarning string
+	}{
+		"satisfied":      {apiUrl: server.URL + "/api", minVersion: "2024.12.0"},
+		"equal":          {apiUrl: server.URL + "/api", minVersion: "2024.12.1"},
+		"outdated":       {apiUrl: server.URL + "/api", minVersion: "2025.1.0", expectError: "Unsupported Bitwarden Server Version"},
+		"missing":        {apiUrl: server.URL + "/missing", minVersion: "2025.1.0", expectWarning: "Unable to Determine Server Version"},
+		"no version":     {apiUrl: server.URL + "/invalid", minVersion: "2025.1.0", expectWarning: "Unable to Determine Server Version"},
+		"invalid config": {apiUrl: server.URL + "/api", minVersion: "latest", expectError: "Invalid Minimum Server Version"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			checkServerVersion(context.Background(), test.apiUrl, test.minVersion, &diags)
+			if test.expectError == "" && diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if test.expectError != "" && !diagnosticsContain(diags, test.expectError) {
+				t.Fatalf("expected %s, got: %v", test.expectError, diags)
+			}
+			if test.expectWarning != "" && !diagnosticsContain(diags, test.expectWarning) {
+				t.Fatalf("expected %s, got: %v", test.expectWarning, diags)
+			}
+		})
+	}
+}
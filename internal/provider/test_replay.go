@@ -0,0 +1,360 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bitwarden/sdk-go/v2"
+)
+
+const (
+	// replayModeKey selects how acceptance tests using newAccTestClient reach Bitwarden Secrets Manager:
+	// "replay" replays the recorded fixture without credentials, "record" runs against the organization
+	// configured in the .env file and overwrites the fixture, and any other value runs against that organization.
+	replayModeKey    = "BW_ACC_REPLAY"
+	replayFixtureDir = "testdata/replay"
+)
+
+var (
+	// Ensure the recorded client types fully satisfy the Bitwarden SDK interfaces.
+	_ sdk.BitwardenClientInterface = &recordedBitwardenClient{}
+	_ sdk.ProjectsInterface        = &recordedProjects{}
+	_ sdk.SecretsInterface         = &recordedSecrets{}
+	_ sdk.GeneratorsInterface      = &recordedGenerators{}
+)
+
+// sdkFixture is the file format of a recorded acceptance test. The access token is never recorded.
+type sdkFixture struct {
+	OrganizationID string           `json:"organization_id"`
+	Interactions   []sdkInteraction `json:"interactions"`
+}
+
+// sdkInteraction is a single recorded Bitwarden SDK call with its arguments and its result.
+type sdkInteraction struct {
+	Operation string          `json:"operation"`
+	Request   json.RawMessage `json:"request"`
+	Response  json.RawMessage `json:"response,omitempty"`
+	Error     string          `json:"error,omitempty"`
+
+	replayed bool
+}
+
+// sdkRecording holds the interactions of an acceptance test. It is shared by all clients of the test, because the
+// provider creates its own client whenever Terraform configures it.
+type sdkRecording struct {
+	mu      sync.Mutex
+	fixture sdkFixture
+}
+
+// record appends an interaction to the recording.
+func (r *sdkRecording) record(operation string, request json.RawMessage, response any, err error) error {
+	interaction := sdkInteraction{Operation: operation, Request: request}
+	if err != nil {
+		interaction.Error = err.Error()
+	} else if response != nil {
+		encoded, marshalErr := json.Marshal(response)
+		if marshalErr != nil {
+			return marshalErr
+		}
+		interaction.Response = encoded
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.fixture.Interactions = append(r.fixture.Interactions, interaction)
+	return nil
+}
+
+// replay returns the next recorded interaction for the given call. Interactions with the same request are replayed
+// in the recorded order, and the last of them is repeated, so that additional reads of Terraform do not break the
+// replay.
+func (r *sdkRecording) replay(operation string, request json.RawMessage) (*sdkInteraction, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var last *sdkInteraction
+	for i := range r.fixture.Interactions {
+		interaction := &r.fixture.Interactions[i]
+		if interaction.Operation != operation || !equalJSON(interaction.Request, request) {
+			continue
+		}
+		if !interaction.replayed {
+			interaction.replayed = true
+			return interaction, nil
+		}
+		last = interaction
+	}
+
+	if last == nil {
+		return nil, fmt.Errorf("no recorded interaction for %s with request %s", operation, request)
+	}
+	return last, nil
+}
+
+// save writes the recording to the fixture file.
+func (r *sdkRecording) save(file string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	encoded, err := json.MarshalIndent(r.fixture, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(file, append(encoded, '\n'), 0o644)
+}
+
+// loadSdkRecording reads a recording from the fixture file.
+func loadSdkRecording(file string) (*sdkRecording, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the replay fixture: %w", err)
+	}
+
+	var recording sdkRecording
+	if err = json.Unmarshal(content, &recording.fixture); err != nil {
+		return nil, fmt.Errorf("unable to parse the replay fixture %s: %w", file, err)
+	}
+	return &recording, nil
+}
+
+func equalJSON(a, b json.RawMessage) bool {
+	var compactA, compactB bytes.Buffer
+	if json.Compact(&compactA, a) != nil || json.Compact(&compactB, b) != nil {
+		return false
+	}
+	return bytes.Equal(compactA.Bytes(), compactB.Bytes())
+}
+
+// recordedBitwardenClient records the calls to the wrapped client, or replays them if no client is wrapped.
+type recordedBitwardenClient struct {
+	client    sdk.BitwardenClientInterface
+	recording *sdkRecording
+}
+
+// recordedCall records or replays a single SDK call identified by the operation and its arguments.
+func recordedCall[T any](c *recordedBitwardenClient, operation string, arguments []any, call func() (*T, error)) (*T, error) {
+	request, err := json.Marshal(arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.client != nil {
+		response, callErr := call()
+		var recorded any
+		if response != nil {
+			recorded = response
+		}
+		if recordErr := c.recording.record(operation, request, recorded, callErr); recordErr != nil {
+			return nil, recordErr
+		}
+		return response, callErr
+	}
+
+	interaction, err := c.recording.replay(operation, request)
+	if err != nil {
+		return nil, err
+	}
+	if interaction.Error != "" {
+		return nil, errors.New(interaction.Error)
+	}
+
+	response := new(T)
+	if len(interaction.Response) > 0 {
+		if err = json.Unmarshal(interaction.Response, response); err != nil {
+			return nil, fmt.Errorf("unable to parse the recorded response of %s: %w", operation, err)
+		}
+	}
+	return response, nil
+}
+
+func (c *recordedBitwardenClient) AccessTokenLogin(accessToken string, stateFile *string) error {
+	// Neither the access token nor the state file are recorded, so that fixtures can be replayed with any token.
+	_, err := recordedCall(c, "AccessTokenLogin", []any{}, func() (*struct{}, error) {
+		return nil, c.client.AccessTokenLogin(accessToken, stateFile)
+	})
+	return err
+}
+
+func (c *recordedBitwardenClient) Projects() sdk.ProjectsInterface {
+	return &recordedProjects{c}
+}
+
+func (c *recordedBitwardenClient) Secrets() sdk.SecretsInterface {
+	return &recordedSecrets{c}
+}
+
+func (c *recordedBitwardenClient) Generators() sdk.GeneratorsInterface {
+	return &recordedGenerators{c}
+}
+
+func (c *recordedBitwardenClient) Close() {
+	if c.client != nil {
+		c.client.Close()
+	}
+}
+
+type recordedProjects struct {
+	client *recordedBitwardenClient
+}
+
+func (p *recordedProjects) Create(organizationID string, name string) (*sdk.ProjectResponse, error) {
+	return recordedCall(p.client, "Projects.Create", []any{organizationID, name}, func() (*sdk.ProjectResponse, error) {
+		return p.client.client.Projects().Create(organizationID, name)
+	})
+}
+
+func (p *recordedProjects) List(organizationID string) (*sdk.ProjectsResponse, error) {
+	return recordedCall(p.client, "Projects.List", []any{organizationID}, func() (*sdk.ProjectsResponse, error) {
+		return p.client.client.Projects().List(organizationID)
+	})
+}
+
+func (p *recordedProjects) Get(projectID string) (*sdk.ProjectResponse, error) {
+	return recordedCall(p.client, "Projects.Get", []any{projectID}, func() (*sdk.ProjectResponse, error) {
+		return p.client.client.Projects().Get(projectID)
+	})
+}
+
+func (p *recordedProjects) Update(projectID string, organizationID string, name string) (*sdk.ProjectResponse, error) {
+	return recordedCall(p.client, "Projects.Update", []any{projectID, organizationID, name}, func() (*sdk.ProjectResponse, error) {
+		return p.client.client.Projects().Update(projectID, organizationID, name)
+	})
+}
+
+func (p *recordedProjects) Delete(projectIDs []string) (*sdk.ProjectsDeleteResponse, error) {
+	return recordedCall(p.client, "Projects.Delete", []any{projectIDs}, func() (*sdk.ProjectsDeleteResponse, error) {
+		return p.client.client.Projects().Delete(projectIDs)
+	})
+}
+
+type recordedSecrets struct {
+	client *recordedBitwardenClient
+}
+
+func (s *recordedSecrets) Create(key, value, note string, organizationID string, projectIDs []string) (*sdk.SecretResponse, error) {
+	return recordedCall(s.client, "Secrets.Create", []any{key, value, note, organizationID, projectIDs}, func() (*sdk.SecretResponse, error) {
+		return s.client.client.Secrets().Create(key, value, note, organizationID, projectIDs)
+	})
+}
+
+func (s *recordedSecrets) List(organizationID string) (*sdk.SecretIdentifiersResponse, error) {
+	return recordedCall(s.client, "Secrets.List", []any{organizationID}, func() (*sdk.SecretIdentifiersResponse, error) {
+		return s.client.client.Secrets().List(organizationID)
+	})
+}
+
+func (s *recordedSecrets) Get(secretID string) (*sdk.SecretResponse, error) {
+	return recordedCall(s.client, "Secrets.Get", []any{secretID}, func() (*sdk.SecretResponse, error) {
+		return s.client.client.Secrets().Get(secretID)
+	})
+}
+
+func (s *recordedSecrets) GetByIDS(secretIDs []string) (*sdk.SecretsResponse, error) {
+	return recordedCall(s.client, "Secrets.GetByIDS", []any{secretIDs}, func() (*sdk.SecretsResponse, error) {
+		return s.client.client.Secrets().GetByIDS(secretIDs)
+	})
+}
+
+func (s *recordedSecrets) Update(secretID string, key, value, note string, organizationID string, projectIDs []string) (*sdk.SecretResponse, error) {
+	return recordedCall(s.client, "Secrets.Update", []any{secretID, key, value, note, organizationID, projectIDs}, func() (*sdk.SecretResponse, error) {
+		return s.client.client.Secrets().Update(secretID, key, value, note, organizationID, projectIDs)
+	})
+}
+
+func (s *recordedSecrets) Delete(secretIDs []string) (*sdk.SecretsDeleteResponse, error) {
+	return recordedCall(s.client, "Secrets.Delete", []any{secretIDs}, func() (*sdk.SecretsDeleteResponse, error) {
+		return s.client.client.Secrets().Delete(secretIDs)
+	})
+}
+
+func (s *recordedSecrets) Sync(organizationID string, lastSyncedDate *time.Time) (*sdk.SecretsSyncResponse, error) {
+	return recordedCall(s.client, "Secrets.Sync", []any{organizationID, lastSyncedDate}, func() (*sdk.SecretsSyncResponse, error) {
+		return s.client.client.Secrets().Sync(organizationID, lastSyncedDate)
+	})
+}
+
+type recordedGenerators struct {
+	client *recordedBitwardenClient
+}
+
+func (g *recordedGenerators) GeneratePassword(request sdk.PasswordGeneratorRequest) (*string, error) {
+	return recordedCall(g.client, "Generators.GeneratePassword", []any{request}, func() (*string, error) {
+		return g.client.client.Generators().GeneratePassword(request)
+	})
+}
+
+// newAccTestClient returns the Bitwarden client, the organization ID and the provider configuration of an acceptance
+// test which can be replayed from the given fixture in testdata/replay, depending on BW_ACC_REPLAY. Tests using it must
+// not depend on random values, because the requests of a replayed test have to match the recorded ones.
+func newAccTestClient(t *testing.T, fixture string) (sdk.BitwardenClientInterface, string, string) {
+	fixtureFile := filepath.Join(replayFixtureDir, fixture+".json")
+
+	switch os.Getenv(replayModeKey) {
+	case "replay":
+		recording, err := loadSdkRecording(fixtureFile)
+		if err != nil {
+			t.Fatalf("Error loading replay fixture: %s", err)
+		}
+
+		client := &recordedBitwardenClient{recording: recording}
+		replaceClientFactory(t, func(_ *string, _ *string) (sdk.BitwardenClientInterface, error) {
+			return client, nil
+		})
+
+		providerConfig := fmt.Sprintf(`
+        provider "bitwarden-secrets" {
+            api_url = "https://api.replay.invalid"
+            identity_url = "https://identity.replay.invalid"
+            access_token = "replay_access_token"
+            organization_id = "%s"
+        }`, recording.fixture.OrganizationID)
+		return client, recording.fixture.OrganizationID, providerConfig
+
+	case "record":
+		bitwardenClient, organizationId, err := newBitwardenClient()
+		if err != nil {
+			t.Fatalf("Error creating bitwardenClient: %s", err)
+		}
+
+		recording := &sdkRecording{fixture: sdkFixture{OrganizationID: organizationId}}
+		originalFactory := createBitwardenClient
+		replaceClientFactory(t, func(apiUrl *string, identityUrl *string) (sdk.BitwardenClientInterface, error) {
+			client, factoryErr := originalFactory(apiUrl, identityUrl)
+			if factoryErr != nil {
+				return nil, factoryErr
+			}
+			return &recordedBitwardenClient{client: client, recording: recording}, nil
+		})
+		t.Cleanup(func() {
+			if saveErr := recording.save(fixtureFile); saveErr != nil {
+				t.Errorf("Error saving replay fixture: %s", saveErr)
+			}
+		})
+
+		return &recordedBitwardenClient{client: bitwardenClient, recording: recording}, organizationId, buildProviderConfigFromEnvFile(t)
+
+	default:
+		bitwardenClient, organizationId, err := newBitwardenClient()
+		if err != nil {
+			t.Fatalf("Error creating bitwardenClient: %s", err)
+		}
+		return bitwardenClient, organizationId, buildProviderConfigFromEnvFile(t)
+	}
+}
+
+// replaceClientFactory replaces the Bitwarden client factory of the provider for the duration of a test.
+func replaceClientFactory(t *testing.T, factory func(apiUrl *string, identityUrl *string) (sdk.BitwardenClientInterface, error)) {
+	originalFactory := createBitwardenClient
+	createBitwardenClient = factory
+	t.Cleanup(func() { createBitwardenClient = originalFactory })
+}
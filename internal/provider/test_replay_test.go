@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"github.com/bitwarden/sdk-go/v2"
+	"github.com/google/uuid"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSdkRecordingReplaysRecordedCalls(t *testing.T) {
+	mock := newMockBitwardenClient()
+	recording := &sdkRecording{fixture: sdkFixture{OrganizationID: mockOrgId}}
+	recorder := &recordedBitwardenClient{client: mock, recording: recording}
+
+	if err := recorder.AccessTokenLogin("token", new(string)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	project, err := recorder.Projects().Create(mockOrgId, "project")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	secret, err := recorder.Secrets().Create("key", "value", "note", mockOrgId, []string{project.ID})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err = recorder.Secrets().Delete([]string{secret.ID}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_, getErr := recorder.Secrets().Get(secret.ID)
+	if getErr == nil {
+		t.Fatal("expected an error reading the deleted secret")
+	}
+
+	fixtureFile := filepath.Join(t.TempDir(), "fixture.json")
+	if err = recording.save(fixtureFile); err != nil {
+		t.Fatalf("unable to save the recording: %v", err)
+	}
+	content, err := os.ReadFile(fixtureFile)
+	if err != nil {
+		t.Fatalf("unable to read the recording: %v", err)
+	}
+	if strings.Contains(string(content), "token") {
+		t.Errorf("expected the access token not to be recorded, got: %s", content)
+	}
+
+	replayed, err := loadSdkRecording(fixtureFile)
+	if err != nil {
+		t.Fatalf("unable to load the recording: %v", err)
+	}
+	replayer := &recordedBitwardenClient{recording: replayed}
+
+	if err = replayer.AccessTokenLogin("other token", new(string)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	replayedProject, err := replayer.Projects().Create(mockOrgId, "project")
+	if err != nil || replayedProject.ID != project.ID {
+		t.Fatalf("expected the recorded project %s, got: %v, %v", project.ID, replayedProject, err)
+	}
+	replayedSecret, err := replayer.Secrets().Create("key", "value", "note", mockOrgId, []string{project.ID})
+	if err != nil || replayedSecret.ID != secret.ID || *replayedSecret.ProjectID != project.ID || !replayedSecret.RevisionDate.Equal(secret.RevisionDate) {
+		t.Fatalf("expected the recorded secret %v, got: %v, %v", secret, replayedSecret, err)
+	}
+	if _, err = replayer.Secrets().Get(secret.ID); err == nil || err.Error() != getErr.Error() {
+		t.Errorf("expected the recorded error %q, got: %v", getErr, err)
+	}
+	if _, err = replayer.Secrets().Create("key", "other value", "note", mockOrgId, []string{project.ID}); err == nil || !strings.Contains(err.Error(), "no recorded interaction for Secrets.Create") {
+		t.Errorf("expected an error for a request which was not recorded, got: %v", err)
+	}
+}
+
+func TestSdkRecordingRepeatsLastInteraction(t *testing.T) {
+	recording, err := loadSdkRecording(filepath.Join(replayFixtureDir, "secret_create.json"))
+	if err != nil {
+		t.Fatalf("unable to load the fixture: %v", err)
+	}
+	var client sdk.BitwardenClientInterface = &recordedBitwardenClient{recording: recording}
+
+	const secretId = "f31b8a07-52ce-4d96-a0e4-19c7b3d5e862"
+	for range 3 {
+		secret, getErr := client.Secrets().Get(secretId)
+		if getErr != nil || secret.Value != "replay-value" {
+			t.Fatalf("expected the recorded secret to be repeated, got: %v, %v", secret, getErr)
+		}
+	}
+}
+
+func TestSecretResourceReplaysFixture(t *testing.T) {
+	recording, err := loadSdkRecording(filepath.Join(replayFixtureDir, "secret_create.json"))
+	if err != nil {
+		t.Fatalf("unable to load the fixture: %v", err)
+	}
+	const projectId = "a4d61e2b-0f97-4c5a-8e3b-7d29c6f1b058"
+	const secretId = "f31b8a07-52ce-4d96-a0e4-19c7b3d5e862"
+
+	r := &secretResource{bitwardenClient: &recordedBitwardenClient{recording: recording}, organizationId: recording.fixture.OrganizationID}
+	schema := secretResourceTestSchema(t)
+
+	createResp := fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	newTestPrivateState(&createResp.Private)
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: newTestPlan(t, schema, secretResourceModel{
+		ID:        types.StringUnknown(),
+		Key:       types.StringValue("Test-Secret-Replay"),
+		Value:     types.StringValue("replay-value"),
+		Note:      types.StringValue("replay-note"),
+		ProjectID: types.StringValue(projectId),
+	})}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", createResp.Diagnostics)
+	}
+
+	readResp := fwresource.ReadResponse{State: createResp.State, Private: createResp.Private}
+	r.Read(context.Background(), fwresource.ReadRequest{State: createResp.State, Private: createResp.Private}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", readResp.Diagnostics)
+	}
+	var state secretResourceModel
+	readResp.State.Get(context.Background(), &state)
+	if state.ID.ValueString() != secretId || state.Value.ValueString() != "replay-value" || state.ProjectID.ValueString() != projectId {
+		t.Fatalf("expected the recorded secret %s, got: %+v", secretId, state)
+	}
+	if state.RevisionDate.ValueString() != "2026-10-16 09:14:05.846 +0000 UTC" {
+		t.Errorf("expected the recorded revision date, got: %q", state.RevisionDate.ValueString())
+	}
+
+	deleteResp := fwresource.DeleteResponse{State: readResp.State}
+	r.Delete(context.Background(), fwresource.DeleteRequest{State: readResp.State}, &deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", deleteResp.Diagnostics)
+	}
+}
+
+func TestReplayFixturesAreValid(t *testing.T) {
+	fixtures, err := filepath.Glob(filepath.Join(replayFixtureDir, "*.json"))
+	if err != nil || len(fixtures) == 0 {
+		t.Fatalf("expected replay fixtures, got: %v, %v", fixtures, err)
+	}
+
+	for _, fixture := range fixtures {
+		recording, loadErr := loadSdkRecording(fixture)
+		if loadErr != nil {
+			t.Errorf("unable to load the fixture %s: %v", fixture, loadErr)
+			continue
+		}
+		if _, uuidErr := uuid.Parse(recording.fixture.OrganizationID); uuidErr != nil {
+			t.Errorf("expected the fixture %s to contain the organization ID, got: %q", fixture, recording.fixture.OrganizationID)
+		}
+	}
+}
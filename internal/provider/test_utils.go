@@ -1,15 +1,26 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"math/rand"
 	"os"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/bitwarden/sdk-go/v2"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -36,6 +47,7 @@ const (
 	accessTokenKey    = "BW_ACCESS_TOKEN"
 	organizationIDKey = "BW_ORGANIZATION_ID"
 	stateFileKey      = "BW_STATE_FILE"
+	mockOrgId         = "2b4a0b4e-0c4a-4e8b-9a5e-1f1d1c0f3a10"
 )
 
 func generateRandomString() string {
@@ -209,3 +221,383 @@ func buildSecretResourceConfig(config SecretResourceConfig) string {
 	}`
 	return configString
 }
+
+// mockBitwardenClient is an in-memory implementation of sdk.BitwardenClientInterface used by unit tests.
+// The hooks allow single operations to be overridden, e.g. to return malformed responses or errors.
+type mockBitwardenClient struct {
+	mu       sync.Mutex
+	projects map[string]sdk.ProjectResponse
+	secrets  map[string]sdk.SecretResponse
+	calls    map[string]int
+
+	secretCreateHook   func(key, value, note string, organizationID string, projectIDs []string) (*sdk.SecretResponse, error)
+	secretGetHook      func(secretID string) (*sdk.SecretResponse, error)
+	secretGetByIdsHook func(secretIDs []string) (*sdk.SecretsResponse, error)
+	secretUpdateHook   func(secretID string, key, value, note string, organizationID string, projectIDs []string) (*sdk.SecretResponse, error)
+	secretDeleteHook   func(secretIDs []string) (*sdk.SecretsDeleteResponse, error)
+	secretListHook     func(organizationID string) (*sdk.SecretIdentifiersResponse, error)
+	projectListHook    func(organizationID string) (*sdk.ProjectsResponse, error)
+	loginHook          func(accessToken string) error
+
+	loginAccessToken string
+	loginStatePath   string
+}
+
+func newMockBitwardenClient() *mockBitwardenClient {
+	return &mockBitwardenClient{
+		projects: map[string]sdk.ProjectResponse{},
+		secrets:  map[string]sdk.SecretResponse{},
+		calls:    map[string]int{},
+	}
+}
+
+func (m *mockBitwardenClient) recordCall(operation string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls[operation]++
+}
+
+func (m *mockBitwardenClient) callCount(operation string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.calls[operation]
+}
+
+func (m *mockBitwardenClient) addProject(organizationID string, name string) sdk.ProjectResponse {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := time.Now().UTC()
+	project := sdk.ProjectResponse{
+		ID:             uuid.NewString(),
+		Name:           name,
+		OrganizationID: organizationID,
+		CreationDate:   now,
+		RevisionDate:   now,
+	}
+	m.projects[project.ID] = project
+	return project
+}
+
+func (m *mockBitwardenClient) addSecret(key, value, note string, organizationID string, projectID string) sdk.SecretResponse {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := time.Now().UTC()
+	secret := sdk.SecretResponse{
+		ID:             uuid.NewString(),
+		Key:            key,
+		Value:          value,
+		Note:           note,
+		OrganizationID: organizationID,
+		ProjectID:      &projectID,
+		CreationDate:   now,
+		RevisionDate:   now,
+	}
+	m.secrets[secret.ID] = secret
+	return secret
+}
+
+func (m *mockBitwardenClient) AccessTokenLogin(accessToken string, statePath *string) error {
+	m.recordCall("AccessTokenLogin")
+	m.mu.Lock()
+	m.loginAccessToken = accessToken
+	m.loginStatePath = *statePath
+	m.mu.Unlock()
+	if m.loginHook != nil {
+		return m.loginHook(accessToken)
+	}
+	return nil
+}
+
+func (m *mockBitwardenClient) Projects() sdk.ProjectsInterface {
+	return &mockProjects{m}
+}
+
+func (m *mockBitwardenClient) Secrets() sdk.SecretsInterface {
+	return &mockSecrets{m}
+}
+
+func (m *mockBitwardenClient) Generators() sdk.GeneratorsInterface {
+	return &mockGenerators{m}
+}
+
+func (m *mockBitwardenClient) Close() {}
+
+type mockProjects struct {
+	client *mockBitwardenClient
+}
+
+func (p *mockProjects) Create(organizationID string, name string) (*sdk.ProjectResponse, error) {
+	p.client.recordCall("Projects.Create")
+	project := p.client.addProject(organizationID, name)
+	return &project, nil
+}
+
+func (p *mockProjects) List(organizationID string) (*sdk.ProjectsResponse, error) {
+	p.client.recordCall("Projects.List")
+	if p.client.projectListHook != nil {
+		return p.client.projectListHook(organizationID)
+	}
+	p.client.mu.Lock()
+	defer p.client.mu.Unlock()
+	response := sdk.ProjectsResponse{Data: []sdk.ProjectResponse{}}
+	for _, project := range p.client.projects {
+		if project.OrganizationID == organizationID {
+			response.Data = append(response.Data, project)
+		}
+	}
+	return &response, nil
+}
+
+func (p *mockProjects) Get(projectID string) (*sdk.ProjectResponse, error) {
+	p.client.recordCall("Projects.Get")
+	p.client.mu.Lock()
+	defer p.client.mu.Unlock()
+	project, ok := p.client.projects[projectID]
+	if !ok {
+		return nil, fmt.Errorf("API error: [404 Not Found] Resource not found")
+	}
+	return &project, nil
+}
+
+func (p *mockProjects) Update(projectID string, organizationID string, name string) (*sdk.ProjectResponse, error) {
+	p.client.recordCall("Projects.Update")
+	p.client.mu.Lock()
+	defer p.client.mu.Unlock()
+	project, ok := p.client.projects[projectID]
+	if !ok {
+		return nil, fmt.Errorf("API error: [404 Not Found] Resource not found")
+	}
+	project.Name = name
+	project.OrganizationID = organizationID
+	project.RevisionDate = time.Now().UTC()
+	p.client.projects[projectID] = project
+	return &project, nil
+}
+
+func (p *mockProjects) Delete(projectIDs []string) (*sdk.ProjectsDeleteResponse, error) {
+	p.client.recordCall("Projects.Delete")
+	p.client.mu.Lock()
+	defer p.client.mu.Unlock()
+	response := sdk.ProjectsDeleteResponse{}
+	for _, id := range projectIDs {
+		delete(p.client.projects, id)
+		response.Data = append(response.Data, sdk.ProjectDeleteResponse{ID: id})
+	}
+	return &response, nil
+}
+
+type mockSecrets struct {
+	client *mockBitwardenClient
+}
+
+func (s *mockSecrets) Create(key, value, note string, organizationID string, projectIDs []string) (*sdk.SecretResponse, error) {
+	s.client.recordCall("Secrets.Create")
+	if s.client.secretCreateHook != nil {
+		return s.client.secretCreateHook(key, value, note, organizationID, projectIDs)
+	}
+	secret := s.client.addSecret(key, value, note, organizationID, projectIDs[0])
+	return &secret, nil
+}
+
+func (s *mockSecrets) List(organizationID string) (*sdk.SecretIdentifiersResponse, error) {
+	s.client.recordCall("Secrets.List")
+	if s.client.secretListHook != nil {
+		return s.client.secretListHook(organizationID)
+	}
+	s.client.mu.Lock()
+	defer s.client.mu.Unlock()
+	response := sdk.SecretIdentifiersResponse{Data: []sdk.SecretIdentifierResponse{}}
+	for _, secret := range s.client.secrets {
+		if secret.OrganizationID == organizationID {
+			response.Data = append(response.Data, sdk.SecretIdentifierResponse{
+				ID:             secret.ID,
+				Key:            secret.Key,
+				OrganizationID: secret.OrganizationID,
+				ProjectIDS:     []string{*secret.ProjectID},
+			})
+		}
+	}
+	return &response, nil
+}
+
+func (s *mockSecrets) Get(secretID string) (*sdk.SecretResponse, error) {
+	s.client.recordCall("Secrets.Get")
+	if s.client.secretGetHook != nil {
+		return s.client.secretGetHook(secretID)
+	}
+	s.client.mu.Lock()
+	defer s.client.mu.Unlock()
+	secret, ok := s.client.secrets[secretID]
+	if !ok {
+		return nil, fmt.Errorf("API error: [404 Not Found] Resource not found")
+	}
+	return &secret, nil
+}
+
+func (s *mockSecrets) GetByIDS(secretIDs []string) (*sdk.SecretsResponse, error) {
+	s.client.recordCall("Secrets.GetByIDS")
+	if s.client.secretGetByIdsHook != nil {
+		return s.client.secretGetByIdsHook(secretIDs)
+	}
+	s.client.mu.Lock()
+	defer s.client.mu.Unlock()
+	response := sdk.SecretsResponse{}
+	for _, id := range secretIDs {
+		secret, ok := s.client.secrets[id]
+		if !ok {
+			return nil, fmt.Errorf("API error: [404 Not Found] Resource not found")
+		}
+		response.Data = append(response.Data, secret)
+	}
+	return &response, nil
+}
+
+func (s *mockSecrets) Update(secretID string, key, value, note string, organizationID string, projectIDs []string) (*sdk.SecretResponse, error) {
+	s.client.recordCall("Secrets.Update")
+	if s.client.secretUpdateHook != nil {
+		return s.client.secretUpdateHook(secretID, key, value, note, organizationID, projectIDs)
+	}
+	s.client.mu.Lock()
+	defer s.client.mu.Unlock()
+	secret, ok := s.client.secrets[secretID]
+	if !ok {
+		return nil, fmt.Errorf("API error: [404 Not Found] Resource not found")
+	}
+	secret.Key = key
+	secret.Value = value
+	secret.Note = note
+	secret.OrganizationID = organizationID
+	secret.ProjectID = &projectIDs[0]
+	secret.RevisionDate = time.Now().UTC()
+	s.client.secrets[secretID] = secret
+	return &secret, nil
+}
+
+func (s *mockSecrets) Delete(secretIDs []string) (*sdk.SecretsDeleteResponse, error) {
+	s.client.recordCall("Secrets.Delete")
+	if s.client.secretDeleteHook != nil {
+		return s.client.secretDeleteHook(secretIDs)
+	}
+	s.client.mu.Lock()
+	defer s.client.mu.Unlock()
+	response := sdk.SecretsDeleteResponse{}
+	for _, id := range secretIDs {
+		if _, ok := s.client.secrets[id]; !ok {
+			notFound := "Secret not found."
+			response.Data = append(response.Data, sdk.SecretDeleteResponse{ID: id, Error: &notFound})
+			continue
+		}
+		delete(s.client.secrets, id)
+		response.Data = append(response.Data, sdk.SecretDeleteResponse{ID: id})
+	}
+	return &response, nil
+}
+
+func (s *mockSecrets) Sync(organizationID string, _ *time.Time) (*sdk.SecretsSyncResponse, error) {
+	s.client.recordCall("Secrets.Sync")
+	s.client.mu.Lock()
+	defer s.client.mu.Unlock()
+	response := sdk.SecretsSyncResponse{HasChanges: true}
+	for _, secret := range s.client.secrets {
+		if secret.OrganizationID == organizationID {
+			response.Secrets = append(response.Secrets, secret)
+		}
+	}
+	return &response, nil
+}
+
+type mockGenerators struct {
+	client *mockBitwardenClient
+}
+
+func (g *mockGenerators) GeneratePassword(request sdk.PasswordGeneratorRequest) (*string, error) {
+	g.client.recordCall("Generators.GeneratePassword")
+	password := strings.Repeat("a", int(request.Length))
+	return &password, nil
+}
+
+// newTestState builds a tfsdk.State for the given schema populated with the given model.
+func newTestState(t *testing.T, schema resourceschema.Schema, model any) tfsdk.State {
+	t.Helper()
+	state := tfsdk.State{Schema: schema}
+	diags := state.Set(context.Background(), model)
+	if diags.HasError() {
+		t.Fatalf("Error building test state: %v", diags)
+	}
+	return state
+}
+
+// newTestPlan builds a tfsdk.Plan for the given schema populated with the given model.
+func newTestPlan(t *testing.T, schema resourceschema.Schema, model any) tfsdk.Plan {
+	t.Helper()
+	state := newTestState(t, schema, model)
+	return tfsdk.Plan{Schema: schema, Raw: state.Raw}
+}
+
+// newTestProviderConfig builds a tfsdk.Config for the schema of the given provider populated with the given model.
+func newTestProviderConfig(t *testing.T, p provider.Provider, model any) tfsdk.Config {
+	t.Helper()
+	schemaResp := provider.SchemaResponse{}
+	p.Schema(context.Background(), provider.SchemaRequest{}, &schemaResp)
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	diags := state.Set(context.Background(), model)
+	if diags.HasError() {
+		t.Fatalf("Error building test provider config: %v", diags)
+	}
+	return tfsdk.Config{Schema: schemaResp.Schema, Raw: state.Raw}
+}
+
+// newTestPrivateState allocates the private state of a request or response, which is done by the framework outside of unit tests.
+func newTestPrivateState[T any](private **T) {
+	*private = new(T)
+}
+
+// newTestConfig builds a tfsdk.Config for the given data source schema populated with the given model.
+func newTestConfig(t *testing.T, schema datasourceschema.Schema, model any) tfsdk.Config {
+	t.Helper()
+	state := tfsdk.State{Schema: schema}
+	diags := state.Set(context.Background(), model)
+	if diags.HasError() {
+		t.Fatalf("Error building test config: %v", diags)
+	}
+	return tfsdk.Config{Schema: schema, Raw: state.Raw}
+}
+
+func secretResourceTestSchema(t *testing.T) resourceschema.Schema {
+	t.Helper()
+	resp := resource.SchemaResponse{}
+	NewSecretResource().Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Error building secret resource schema: %v", resp.Diagnostics)
+	}
+	return resp.Schema
+}
+
+func resourceTestSchema(t *testing.T, r resource.Resource) resourceschema.Schema {
+	t.Helper()
+	resp := resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Error building resource schema: %v", resp.Diagnostics)
+	}
+	return resp.Schema
+}
+
+func dataSourceTestSchema(t *testing.T, dataSource datasource.DataSource) datasourceschema.Schema {
+	t.Helper()
+	resp := datasource.SchemaResponse{}
+	dataSource.Schema(context.Background(), datasource.SchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Error building data source schema: %v", resp.Diagnostics)
+	}
+	return resp.Schema
+}
+
+func diagnosticsContain(diags diag.Diagnostics, summary string) bool {
+	for _, d := range diags {
+		if strings.Contains(d.Summary(), summary) || strings.Contains(d.Detail(), summary) {
+			return true
+		}
+	}
+	return false
+}
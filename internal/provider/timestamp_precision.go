@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"time"
+
+	"github.com/bitwarden/sdk-go/v2"
+)
+
+var (
+	// Ensure the truncating client types fully satisfy the Bitwarden SDK interfaces.
+	_ sdk.BitwardenClientInterface = &truncatingBitwardenClient{}
+	_ sdk.ProjectsInterface        = &truncatingProjects{}
+	_ sdk.SecretsInterface         = &truncatingSecrets{}
+)
+
+// truncatingBitwardenClient wraps a Bitwarden client and truncates the creation and revision dates of every secret and
+// project read by the provider to whole seconds if truncate_timestamps is enabled, so that sub-second differences of
+// the API do not cause differences in the Terraform state. It applies to all resources and data sources alike.
+type truncatingBitwardenClient struct {
+	sdk.BitwardenClientInterface
+}
+
+func newTruncatingBitwardenClient(client sdk.BitwardenClientInterface) sdk.BitwardenClientInterface {
+	return &truncatingBitwardenClient{BitwardenClientInterface: client}
+}
+
+func (c *truncatingBitwardenClient) Projects() sdk.ProjectsInterface {
+	return &truncatingProjects{ProjectsInterface: c.BitwardenClientInterface.Projects()}
+}
+
+func (c *truncatingBitwardenClient) Secrets() sdk.SecretsInterface {
+	return &truncatingSecrets{SecretsInterface: c.BitwardenClientInterface.Secrets()}
+}
+
+type truncatingProjects struct {
+	sdk.ProjectsInterface
+}
+
+func (p *truncatingProjects) Create(organizationID string, name string) (*sdk.ProjectResponse, error) {
+	project, err := p.ProjectsInterface.Create(organizationID, name)
+	truncateProjectTimestamps(project)
+	return project, err
+}
+
+func (p *truncatingProjects) Get(projectID string) (*sdk.ProjectResponse, error) {
+	project, err := p.ProjectsInterface.Get(projectID)
+	truncateProjectTimestamps(project)
+	return project, err
+}
+
+func (p *truncatingProjects) List(organizationID string) (*sdk.ProjectsResponse, error) {
+	projects, err := p.ProjectsInterface.List(organizationID)
+	if projects != nil {
+		for i := range projects.Data {
+			truncateProjectTimestamps(&projects.Data[i])
+		}
+	}
+	return projects, err
+}
+
+func (p *truncatingProjects) Update(projectID, organizationID, name string) (*sdk.ProjectResponse, error) {
+	project, err := p.ProjectsInterface.Update(projectID, organizationID, name)
+	truncateProjectTimestamps(project)
+	return project, err
+}
+
+type truncatingSecrets struct {
+	sdk.SecretsInterface
+}
+
+func (s *truncatingSecrets) Create(key, value, note string, organizationID string, projectIDs []string) (*sdk.SecretResponse, error) {
+	secret, err := s.SecretsInterface.Create(key, value, note, organizationID, projectIDs)
+	truncateSecretTimestamps(secret)
+	return secret, err
+}
+
+func (s *truncatingSecrets) Get(secretID string) (*sdk.SecretResponse, error) {
+	secret, err := s.SecretsInterface.Get(secretID)
+	truncateSecretTimestamps(secret)
+	return secret, err
+}
+
+func (s *truncatingSecrets) GetByIDS(secretIDs []string) (*sdk.SecretsResponse, error) {
+	secrets, err := s.SecretsInterface.GetByIDS(secretIDs)
+	if secrets != nil {
+		for i := range secrets.Data {
+			truncateSecretTimestamps(&secrets.Data[i])
+		}
+	}
+	return secrets, err
+}
+
+func (s *truncatingSecrets) Update(secretID string, key, value, note string, organizationID string, projectIDs []string) (*sdk.SecretResponse, error) {
+	secret, err := s.SecretsInterface.Update(secretID, key, value, note, organizationID, projectIDs)
+	truncateSecretTimestamps(secret)
+	return secret, err
+}
+
+func (s *truncatingSecrets) Sync(organizationID string, lastSyncedDate *time.Time) (*sdk.SecretsSyncResponse, error) {
+	response, err := s.SecretsInterface.Sync(organizationID, lastSyncedDate)
+	if response != nil {
+		for i := range response.Secrets {
+			truncateSecretTimestamps(&response.Secrets[i])
+		}
+	}
+	return response, err
+}
+
+func truncateSecretTimestamps(secret *sdk.SecretResponse) {
+	if secret != nil {
+		secret.CreationDate = secret.CreationDate.Truncate(time.Second)
+		secret.RevisionDate = secret.RevisionDate.Truncate(time.Second)
+	}
+}
+
+func truncateProjectTimestamps(project *sdk.ProjectResponse) {
+	if project != nil {
+		project.CreationDate = project.CreationDate.Truncate(time.Second)
+		project.RevisionDate = project.RevisionDate.Truncate(time.Second)
+	}
+}
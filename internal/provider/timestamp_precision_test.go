@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"github.com/bitwarden/sdk-go/v2"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"testing"
+	"time"
+)
+
+func TestTruncatingBitwardenClient(t *testing.T) {
+	precise := time.Date(2024, 5, 1, 10, 20, 30, 123456789, time.UTC)
+	mock := newMockBitwardenClient()
+	project := mock.addProject(mockOrgId, "project")
+	project.CreationDate, project.RevisionDate = precise, precise
+	mock.projects[project.ID] = project
+	secret := mock.addSecret("KEY", "value", "", mockOrgId, project.ID)
+	secret.CreationDate, secret.RevisionDate = precise, precise.Add(time.Millisecond)
+	mock.secrets[secret.ID] = secret
+
+	tests := map[string]struct {
+		truncate     bool
+		creationDate string
+		revisionDate string
+	}{
+		"full precision": {creationDate: "2024-05-01 10:20:30.123456789 +0000 UTC", revisionDate: "2024-05-01 10:20:30.124456789 +0000 UTC"},
+		"truncated":      {truncate: true, creationDate: "2024-05-01 10:20:30 +0000 UTC", revisionDate: "2024-05-01 10:20:30 +0000 UTC"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var client sdk.BitwardenClientInterface = mock
+			if test.truncate {
+				client = newTruncatingBitwardenClient(client)
+			}
+
+			d := &secretDataSource{bitwardenClient: client, organizationId: mockOrgId}
+			schema := dataSourceTestSchema(t, d)
+			resp := datasource.ReadResponse{State: tfsdk.State{Schema: schema}}
+			d.Read(context.Background(), datasource.ReadRequest{Config: newTestConfig(t, schema, secretDataSourceModel{ID: types.StringValue(secret.ID)})}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var state secretDataSourceModel
+			resp.State.Get(context.Background(), &state)
+			if state.CreationDate.ValueString() != test.creationDate || state.RevisionDate.ValueString() != test.revisionDate {
+				t.Fatalf("expected the dates %q and %q, got: %q and %q", test.creationDate, test.revisionDate, state.CreationDate.ValueString(), state.RevisionDate.ValueString())
+			}
+
+			projects, err := client.Projects().List(mockOrgId)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if date := timestampValue(projects.Data[0].RevisionDate).ValueString(); date != test.creationDate {
+				t.Fatalf("expected the project revision date %q, got: %q", test.creationDate, date)
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bitwarden/sdk-go/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	// Ensure the timing client types fully satisfy the Bitwarden SDK interfaces.
+	_ sdk.BitwardenClientInterface = &timingBitwardenClient{}
+	_ sdk.ProjectsInterface        = &timingProjects{}
+	_ sdk.SecretsInterface         = &timingSecrets{}
+	_ sdk.GeneratorsInterface      = &timingGenerators{}
+)
+
+// sdkCallTimer measures the wall-clock duration of Bitwarden SDK calls. The Bitwarden SDK does not accept a context,
+// so the durations are logged with the context of the provider configuration.
+type sdkCallTimer struct {
+	ctx          context.Context
+	logDurations bool
+	summary      *operationSummary
+}
+
+// record logs the duration of an SDK call started at start and adds it to the summary. It is meant to be deferred with
+// time.Now() as start. Calls which do not target an object, e.g. listings, only log their organization.
+func (t sdkCallTimer) record(operation string, id string, organizationId string, start time.Time) {
+	duration := time.Since(start)
+	t.summary.addSdkCall(duration)
+
+	if !t.logDurations {
+		return
+	}
+	fields := map[string]any{
+		"operation":   operation,
+		"id":          id,
+		"duration_ms": duration.Milliseconds(),
+	}
+	if organizationId != "" {
+		fields["organization_id"] = organizationId
+	}
+	tflog.SubsystemInfo(t.ctx, logSubsystem, "Bitwarden SDK call finished", fields)
+}
+
+// timingBitwardenClient wraps a Bitwarden client and measures the duration of every SDK call if log_timings or
+// log_summary is enabled.
+type timingBitwardenClient struct {
+	sdk.BitwardenClientInterface
+	timer sdkCallTimer
+}
+
+func newTimingBitwardenClient(ctx context.Context, client sdk.BitwardenClientInterface, logDurations bool, summary *operationSummary) sdk.BitwardenClientInterface {
+	return &timingBitwardenClient{BitwardenClientInterface: client, timer: sdkCallTimer{ctx: ctx, logDurations: logDurations, summary: summary}}
+}
+
+func (c *timingBitwardenClient) AccessTokenLogin(accessToken string, stateFile *string) error {
+	defer c.timer.record("AccessTokenLogin", "", "", time.Now())
+	return c.BitwardenClientInterface.AccessTokenLogin(accessToken, stateFile)
+}
+
+func (c *timingBitwardenClient) Projects() sdk.ProjectsInterface {
+	return &timingProjects{ProjectsInterface: c.BitwardenClientInterface.Projects(), timer: c.timer}
+}
+
+func (c *timingBitwardenClient) Secrets() sdk.SecretsInterface {
+	return &timingSecrets{SecretsInterface: c.BitwardenClientInterface.Secrets(), timer: c.timer}
+}
+
+func (c *timingBitwardenClient) Generators() sdk.GeneratorsInterface {
+	return &timingGenerators{GeneratorsInterface: c.BitwardenClientInterface.Generators(), timer: c.timer}
+}
+
+type timingProjects struct {
+	sdk.ProjectsInterface
+	timer sdkCallTimer
+}
+
+func (p *timingProjects) Create(organizationID string, name string) (*sdk.ProjectResponse, error) {
+	defer p.timer.record("Projects.Create", "", organizationID, time.Now())
+	return p.ProjectsInterface.Create(organizationID, name)
+}
+
+func (p *timingProjects) List(organizationID string) (*sdk.ProjectsResponse, error) {
+	defer p.timer.record("Projects.List", "", organizationID, time.Now())
+	return p.ProjectsInterface.List(organizationID)
+}
+
+func (p *timingProjects) Get(projectID string) (*sdk.ProjectResponse, error) {
+	defer p.timer.record("Projects.Get", projectID, "", time.Now())
+	return p.ProjectsInterface.Get(projectID)
+}
+
+func (p *timingProjects) Update(projectID string, organizationID string, name string) (*sdk.ProjectResponse, error) {
+	defer p.timer.record("Projects.Update", projectID, organizationID, time.Now())
+	return p.ProjectsInterface.Update(projectID, organizationID, name)
+}
+
+func (p *timingProjects) Delete(projectIDs []string) (*sdk.ProjectsDeleteResponse, error) {
+	defer p.timer.record("Projects.Delete", strings.Join(projectIDs, ","), "", time.Now())
+	return p.ProjectsInterface.Delete(projectIDs)
+}
+
+type timingSecrets struct {
+	sdk.SecretsInterface
+	timer sdkCallTimer
+}
+
+func (s *timingSecrets) Create(key, value, note string, organizationID string, projectIDs []string) (*sdk.SecretResponse, error) {
+	defer s.timer.record("Secrets.Create", "", organizationID, time.Now())
+	return s.SecretsInterface.Create(key, value, note, organizationID, projectIDs)
+}
+
+func (s *timingSecrets) List(organizationID string) (*sdk.SecretIdentifiersResponse, error) {
+	defer s.timer.record("Secrets.List", "", organizationID, time.Now())
+	return s.SecretsInterface.List(organizationID)
+}
+
+func (s *timingSecrets) Get(secretID string) (*sdk.SecretResponse, error) {
+	defer s.timer.record("Secrets.Get", secretID, "", time.Now())
+	return s.SecretsInterface.Get(secretID)
+}
+
+func (s *timingSecrets) GetByIDS(secretIDs []string) (*sdk.SecretsResponse, error) {
+	defer s.timer.record("Secrets.GetByIDS", strings.Join(secretIDs, ","), "", time.Now())
+	return s.SecretsInterface.GetByIDS(secretIDs)
+}
+
+func (s *timingSecrets) Update(secretID string, key, value, note string, organizationID string, projectIDs []string) (*sdk.SecretResponse, error) {
+	defer s.timer.record("Secrets.Update", secretID, organizationID, time.Now())
+	return s.SecretsInterface.Update(secretID, key, value, note, organizationID, projectIDs)
+}
+
+func (s *timingSecrets) Delete(secretIDs []string) (*sdk.SecretsDeleteResponse, error) {
+	defer s.timer.record("Secrets.Delete", strings.Join(secretIDs, ","), "", time.Now())
+	return s.SecretsInterface.Delete(secretIDs)
+}
+
+func (s *timingSecrets) Sync(organizationID string, lastSyncedDate *time.Time) (*sdk.SecretsSyncResponse, error) {
+	defer s.timer.record("Secrets.Sync", "", organizationID, time.Now())
+	return s.SecretsInterface.Sync(organizationID, lastSyncedDate)
+}
+
+type timingGenerators struct {
+	sdk.GeneratorsInterface
+	timer sdkCallTimer
+}
+
+func (g *timingGenerators) GeneratePassword(request sdk.PasswordGeneratorRequest) (*string, error) {
+	defer g.timer.record("Generators.GeneratePassword", "", "", time.Now())
+	return g.GeneratorsInterface.GeneratePassword(request)
+}
+
+// operationSummary accumulates the operations of a provider instance if log_summary is enabled. Terraform does not
+// notify providers at the end of a run, so the cumulative summary is logged after every operation and the last summary
+// of a run covers the whole run. All methods do nothing on a nil summary.
+type operationSummary struct {
+	mu       sync.Mutex
+	creates  int
+	updates  int
+	deletes  int
+	sdkCalls int
+	sdkTime  time.Duration
+}
+
+func (s *operationSummary) addSdkCall(duration time.Duration) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sdkCalls++
+	s.sdkTime += duration
+}
+
+// recordIfSucceeded records a create, update or delete of a resource unless it reported an error. It is meant to be
+// deferred by the Create, Update and Delete methods of all resources.
+func (s *operationSummary) recordIfSucceeded(ctx context.Context, operation string, diags *diag.Diagnostics) {
+	if diags.HasError() {
+		return
+	}
+	s.recordOperation(ctx, operation)
+}
+
+// recordOperation adds a successful create, update or delete to the summary and logs the summary.
+func (s *operationSummary) recordOperation(ctx context.Context, operation string) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch operation {
+	case "create":
+		s.creates++
+	case "update":
+		s.updates++
+	case "delete":
+		s.deletes++
+	}
+
+	tflog.SubsystemInfo(ctx, logSubsystem, "Bitwarden Secrets Manager operation summary", map[string]any{
+		"creates":        s.creates,
+		"updates":        s.updates,
+		"deletes":        s.deletes,
+		"sdk_calls":      s.sdkCalls,
+		"sdk_time_ms":    s.sdkTime.Milliseconds(),
+		"last_operation": operation,
+	})
+}
@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"github.com/bitwarden/sdk-go/v2"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"path/filepath"
+	"testing"
+)
+
+func TestTimingBitwardenClientLogsDurations(t *testing.T) {
+	var output bytes.Buffer
+	ctx := newLogContext(tflogtest.RootLogger(context.Background(), &output), "")
+
+	mock := newMockBitwardenClient()
+	secret := mock.addSecret("key", "value", "", mockOrgId, validProjectUUID)
+	client := newTimingBitwardenClient(ctx, mock, true, nil)
+
+	if _, err := client.Secrets().Get(secret.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.Projects().List(mockOrgId); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("unable to decode log output: %v", err)
+	}
+
+	expected := []struct{ operation, id, organizationId string }{{"Secrets.Get", secret.ID, ""}, {"Projects.List", "", mockOrgId}}
+	if len(entries) != len(expected) {
+		t.Fatalf("expected %d log entries, got: %v", len(expected), entries)
+	}
+	for i, entry := range entries {
+		if entry["operation"] != expected[i].operation || entry["id"] != expected[i].id {
+			t.Errorf("expected log entry for %s on %s, got: %v", expected[i].operation, expected[i].id, entry)
+		}
+		if organizationId, _ := entry["organization_id"].(string); organizationId != expected[i].organizationId {
+			t.Errorf("expected log entry for %s in the organization %q, got: %v", expected[i].operation, expected[i].organizationId, entry)
+		}
+		if _, ok := entry["duration_ms"]; !ok {
+			t.Errorf("expected log entry with duration_ms, got: %v", entry)
+		}
+	}
+}
+
+func TestProviderConfigureLogTimings(t *testing.T) {
+	originalFactory := createBitwardenClient
+	createBitwardenClient = func(_ *string, _ *string) (sdk.BitwardenClientInterface, error) {
+		return newMockBitwardenClient(), nil
+	}
+	t.Cleanup(func() { createBitwardenClient = originalFactory })
+
+	for _, logTimings := range []bool{false, true} {
+		p := New("test")()
+		resp := provider.ConfigureResponse{}
+		p.Configure(context.Background(), provider.ConfigureRequest{Config: newTestProviderConfig(t, p, BitwardenSecretsManagerProviderModel{
+			ApiUrl:         types.StringValue("https://api.bitwarden.com"),
+			IdentityUrl:    types.StringValue("https://identity.bitwarden.com"),
+			AccessToken:    types.StringValue("token"),
+			OrganizationId: types.StringValue(mockOrgId),
+			LogTimings:     types.BoolValue(logTimings),
+		})}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %v", resp.Diagnostics)
+		}
+
+		_, timed := resp.ResourceData.(BitwardenSecretsManagerProviderDataStruct).bitwardenClient.(*timingBitwardenClient)
+		if timed != logTimings {
+			t.Errorf("expected the client to log timings to be %t, got: %t", logTimings, timed)
+		}
+	}
+}
+
+func TestSecretResourceLogSummary(t *testing.T) {
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	summary := &operationSummary{}
+	client := newTimingBitwardenClient(newLogContext(ctx, ""), newMockBitwardenClient(), false, summary)
+	r := &secretResource{bitwardenClient: client, organizationId: mockOrgId, summary: summary}
+	schema := secretResourceTestSchema(t)
+
+	createResp := fwresource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	newTestPrivateState(&createResp.Private)
+	r.Create(ctx, fwresource.CreateRequest{Plan: newTestPlan(t, schema, secretResourceModel{
+		ID:        types.StringUnknown(),
+		Key:       types.StringValue("key"),
+		Value:     types.StringValue("value"),
+		ProjectID: types.StringValue(validProjectUUID),
+	})}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error creating secret: %v", createResp.Diagnostics)
+	}
+
+	deleteResp := fwresource.DeleteResponse{State: createResp.State}
+	r.Delete(ctx, fwresource.DeleteRequest{State: createResp.State}, &deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error deleting secret: %v", deleteResp.Diagnostics)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("unable to decode log output: %v", err)
+	}
+
+	var summaries []map[string]any
+	for _, entry := range entries {
+		if entry["@message"] == "Bitwarden Secrets Manager operation summary" {
+			summaries = append(summaries, entry)
+		}
+		if entry["@message"] == "Bitwarden SDK call finished" {
+			t.Errorf("expected no SDK call durations without log_timings, got: %v", entry)
+		}
+	}
+	if len(summaries) != 2 {
+		t.Fatalf("expected a summary after every operation, got: %v", summaries)
+	}
+	last := summaries[1]
+	if last["creates"] != 1.0 || last["updates"] != 0.0 || last["deletes"] != 1.0 || last["sdk_calls"] != 2.0 {
+		t.Errorf("expected the summary to cover the whole run, got: %v", last)
+	}
+	if _, ok := last["sdk_time_ms"]; !ok {
+		t.Errorf("expected the summary to contain the SDK time, got: %v", last)
+	}
+}
+
+func TestResourcesRecordOperations(t *testing.T) {
+	summary := &operationSummary{}
+	mock := newMockBitwardenClient()
+	project := mock.addProject(mockOrgId, "app")
+	client := newTimingBitwardenClient(context.Background(), mock, false, summary)
+
+	secrets := &projectSecretsResource{bitwardenClient: client, organizationId: mockOrgId, summary: summary}
+	secretsSchema := resourceTestSchema(t, secrets)
+	secretsResp := fwresource.CreateResponse{State: tfsdk.State{Schema: secretsSchema}}
+	newTestPrivateState(&secretsResp.Private)
+	secrets.Create(context.Background(), fwresource.CreateRequest{Plan: newTestPlan(t, secretsSchema, projectSecretsTestModel(project.ID, map[string]projectSecretsResourceSecret{
+		"API_KEY": projectSecret("abc123", ""),
+	}))}, &secretsResp)
+	if secretsResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", secretsResp.Diagnostics)
+	}
+
+	file := &secretsFileResource{bitwardenClient: client, organizationId: mockOrgId, summary: summary}
+	fileSchema := resourceTestSchema(t, file)
+	fileResp := fwresource.CreateResponse{State: tfsdk.State{Schema: fileSchema}}
+	file.Create(context.Background(), fwresource.CreateRequest{Plan: newTestPlan(t, fileSchema, secretsFileResourceModel{
+		ID:             types.StringUnknown(),
+		ProjectID:      types.StringValue(project.ID),
+		Path:           types.StringValue(filepath.Join(t.TempDir(), "app.env")),
+		Format:         types.StringValue("env"),
+		FilePermission: types.StringValue("0600"),
+		ContentSha256:  types.StringUnknown(),
+	})}, &fileResp)
+	if fileResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", fileResp.Diagnostics)
+	}
+
+	fileDeleteResp := fwresource.DeleteResponse{State: fileResp.State}
+	file.Delete(context.Background(), fwresource.DeleteRequest{State: fileResp.State}, &fileDeleteResp)
+	secretsDeleteResp := fwresource.DeleteResponse{State: secretsResp.State, Private: secretsResp.Private}
+	secrets.Delete(context.Background(), fwresource.DeleteRequest{State: secretsResp.State, Private: secretsResp.Private}, &secretsDeleteResp)
+	if fileDeleteResp.Diagnostics.HasError() || secretsDeleteResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v %v", fileDeleteResp.Diagnostics, secretsDeleteResp.Diagnostics)
+	}
+
+	if summary.creates != 2 || summary.deletes != 2 {
+		t.Errorf("expected 2 creates and deletes in the summary, got %d and %d", summary.creates, summary.deletes)
+	}
+}
@@ -2,10 +2,22 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
 
+	"github.com/bitwarden/sdk-go/v2"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ validator.String = &stringUUIDValidator{}
@@ -38,3 +50,245 @@ func (v stringUUIDValidator) ValidateString(_ context.Context, req validator.Str
 func stringUUIDValidate() stringUUIDValidator {
 	return stringUUIDValidator{}
 }
+
+// recoverFromPanic converts a panic raised while handling a Bitwarden SDK response into an error diagnostic,
+// so that a malformed response does not crash the provider. It must be deferred by the method it protects.
+func recoverFromPanic(ctx context.Context, operation string, id string, diags *diag.Diagnostics) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	if id == "" {
+		id = "<unknown>"
+	}
+
+	tflog.SubsystemError(ctx, logSubsystem, "Recovered from panic while handling Bitwarden SDK response", map[string]any{
+		"operation": operation,
+		"id":        id,
+		"panic":     fmt.Sprint(r),
+	})
+
+	diags.AddError(
+		"Unexpected Bitwarden Secrets Manager Response",
+		fmt.Sprintf("The provider recovered from an unexpected error during the operation \"%s\" on the object with id: %s. "+
+			"This is most likely caused by a malformed response of the Bitwarden Secrets Manager API. "+
+			"Please report this issue to the provider developers.\n\n"+
+			"Error: %v", operation, id, r),
+	)
+}
+
+// validateSecretResponse verifies that a secret returned by the Bitwarden SDK contains all fields the provider relies on.
+func validateSecretResponse(secret *sdk.SecretResponse) error {
+	if secret == nil {
+		return errors.New("the Bitwarden Secrets Manager API returned an empty secret")
+	}
+
+	if secret.ID == "" {
+		return errors.New("the Bitwarden Secrets Manager API returned a secret without an ID")
+	}
+
+	if secret.ProjectID == nil {
+		return fmt.Errorf("the Bitwarden Secrets Manager API returned the secret with id: %s without a project ID", secret.ID)
+	}
+
+	return nil
+}
+
+// unexpectedResponseError is returned by readSecret if the Bitwarden Secrets Manager API returned a secret which the
+// provider cannot handle, as opposed to errors of the Bitwarden SDK.
+type unexpectedResponseError struct {
+	err error
+}
+
+func (e *unexpectedResponseError) Error() string {
+	return e.err.Error()
+}
+
+func (e *unexpectedResponseError) Unwrap() error {
+	return e.err
+}
+
+// readSecret reads the secret with the given ID and validates the response. Errors of the Bitwarden SDK are returned
+// unchanged, so that callers can handle missing secrets with isNotFoundError.
+func readSecret(client sdk.BitwardenClientInterface, secretId string) (*sdk.SecretResponse, error) {
+	secret, err := client.Secrets().Get(secretId)
+	if err != nil {
+		return nil, err
+	}
+	if err = validateSecretResponse(secret); err != nil {
+		return nil, &unexpectedResponseError{err: err}
+	}
+	return secret, nil
+}
+
+// addReadSecretError reports an error returned by readSecret.
+func addReadSecretError(diags *diag.Diagnostics, secretId string, err error, organizationId string, verboseErrors bool) {
+	var unexpected *unexpectedResponseError
+	if errors.As(err, &unexpected) {
+		diags.AddError(
+			"Unexpected Bitwarden Secrets Manager Response",
+			err.Error(),
+		)
+		return
+	}
+	diags.AddError(
+		"Unable to Read Secret with id: "+secretId,
+		sdkErrorDetail(err, organizationId, verboseErrors),
+	)
+}
+
+// secretMetadata returns the project_id, organization_id, creation_date and revision_date of a validated secret, which
+// the secret resource and data source store identically.
+func secretMetadata(secret *sdk.SecretResponse) (projectId, organizationId, creationDate, revisionDate types.String) {
+	return types.StringValue(*secret.ProjectID), types.StringValue(secret.OrganizationID), timestampValue(secret.CreationDate), timestampValue(secret.RevisionDate)
+}
+
+// timestampValue returns a timestamp of the Bitwarden Secrets Manager API as it is stored in the Terraform state.
+func timestampValue(timestamp time.Time) types.String {
+	return types.StringValue(timestamp.String())
+}
+
+// parseTimestampValue parses a timestamp as it is stored in the Terraform state by timestampValue.
+func parseTimestampValue(timestamp string) (time.Time, error) {
+	return time.Parse("2006-01-02 15:04:05.999999999 -0700 MST", timestamp)
+}
+
+// listProjectSecrets fetches all secrets of the given project, including their values. The Bitwarden SDK can only list
+// the secret identifiers of a whole organization, so the identifiers are filtered by project before the secrets are
+// fetched in a single request. A project outside the scope of the access token has no visible secrets, so if no
+// secret matches, errProjectNotVisible is returned unless the project is listed. If the projects cannot be listed,
+// the project is treated as empty.
+func listProjectSecrets(client sdk.BitwardenClientInterface, cache *projectCache, organizationId string, projectId string) ([]sdk.SecretResponse, error) {
+	identifiers, err := client.Secrets().List(organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	if identifiers == nil {
+		return nil, errors.New("the Bitwarden Secrets Manager API returned an empty response when listing secrets")
+	}
+
+	var secretIds []string
+	for _, identifier := range identifiers.Data {
+		if slices.Contains(identifier.ProjectIDS, projectId) {
+			secretIds = append(secretIds, identifier.ID)
+		}
+	}
+
+	if len(secretIds) == 0 {
+		if projects, err := cache.list(client, organizationId); err == nil &&
+			!slices.ContainsFunc(projects, func(project sdk.ProjectResponse) bool { return project.ID == projectId }) {
+			return nil, fmt.Errorf("%w: %s", errProjectNotVisible, projectId)
+		}
+		return []sdk.SecretResponse{}, nil
+	}
+
+	secrets, err := client.Secrets().GetByIDS(secretIds)
+	if err != nil {
+		return nil, err
+	}
+
+	if secrets == nil {
+		return nil, errors.New("the Bitwarden Secrets Manager API returned an empty response when fetching secrets")
+	}
+
+	return secrets.Data, nil
+}
+
+// hashSecretValue returns the hex encoded SHA-256 hash of a secret value, which allows comparing values without exposing them.
+func hashSecretValue(value string) string {
+	hash := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(hash[:])
+}
+
+// secretValuesByKey maps the keys of the given secrets to their values, optionally converting the keys to uppercase.
+// Keys are not unique inside Bitwarden Secrets Manager, so the value of the most recently revised secret is used for
+// duplicated keys. The duplicated keys are returned sorted.
+func secretValuesByKey(secrets []sdk.SecretResponse, uppercaseKeys bool) (map[string]string, []string) {
+	sorted := slices.Clone(secrets)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].RevisionDate.Before(sorted[j].RevisionDate)
+	})
+
+	values := make(map[string]string, len(sorted))
+	var duplicates []string
+	for _, secret := range sorted {
+		key := secret.Key
+		if uppercaseKeys {
+			key = strings.ToUpper(key)
+		}
+		if _, found := values[key]; found && !slices.Contains(duplicates, key) {
+			duplicates = append(duplicates, key)
+		}
+		values[key] = secret.Value
+	}
+
+	sort.Strings(duplicates)
+	return values, duplicates
+}
+
+// addDuplicatedKeysWarning warns about duplicated secret keys of a project, whose values were resolved by secretValuesByKey.
+func addDuplicatedKeysWarning(diags *diag.Diagnostics, projectId string, duplicates []string, redactKeys bool) {
+	if len(duplicates) == 0 {
+		return
+	}
+
+	keys := make([]string, 0, len(duplicates))
+	for _, key := range duplicates {
+		keys = append(keys, displaySecretKey(key, redactKeys))
+	}
+
+	diags.AddWarning(
+		"Duplicated Secret Keys",
+		fmt.Sprintf("The project with id: %s contains multiple secrets with the keys: %s. "+
+			"The value of the most recently revised secret is used for each of them.", projectId, strings.Join(keys, ", ")),
+	)
+}
+
+// displaySecretKey returns the key of a secret as it is shown in logs and diagnostics. If redact is true, a stable
+// hash of the key is returned instead of the plaintext key.
+func displaySecretKey(key string, redact bool) string {
+	if !redact {
+		return key
+	}
+	return "sha256:" + hashSecretValue(key)[:12]
+}
+
+// validateSecretKey verifies that a secret key contains no control characters and, unless allowWhitespace is true,
+// no leading or trailing whitespace. The returned error references a hash of the key, because configurations are
+// validated before the provider is configured, when it is not yet known whether redact_keys is enabled.
+func validateSecretKey(key string, allowWhitespace bool) error {
+	for position, character := range key {
+		if unicode.IsControl(character) {
+			return fmt.Errorf("the secret key %q contains the control character %U at position %d, which is not allowed", displaySecretKey(key, true), character, position)
+		}
+	}
+
+	if !allowWhitespace && strings.TrimSpace(key) != key {
+		return fmt.Errorf("the secret key %q has leading or trailing whitespace. "+
+			"Remove the whitespace or set allow_whitespace_keys to true if the key is used by legacy secrets", displaySecretKey(key, true))
+	}
+
+	return nil
+}
+
+// checkContext verifies that the context of an operation has neither been cancelled nor exceeded its deadline before
+// the Bitwarden SDK is called. The Bitwarden SDK does not accept a context, so a started call cannot be interrupted.
+func checkContext(ctx context.Context, operation string, diags *diag.Diagnostics) bool {
+	err := ctx.Err()
+	if err == nil {
+		return true
+	}
+
+	reason := "the Terraform operation was cancelled"
+	if errors.Is(err, context.DeadlineExceeded) {
+		reason = "the deadline of the Terraform operation was exceeded"
+	}
+
+	diags.AddError(
+		"Operation Cancelled",
+		fmt.Sprintf("The operation \"%s\" was not started, because %s.", operation, reason),
+	)
+	return false
+}
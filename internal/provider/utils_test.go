@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"github.com/bitwarden/sdk-go/v2"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"testing"
+)
+
+func TestReadSecret(t *testing.T) {
+	client := newMockBitwardenClient()
+	secret := client.addSecret("key", "value", "note", mockOrgId, validProjectUUID)
+
+	read, err := readSecret(client, secret.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if read.ID != secret.ID || read.Value != "value" {
+		t.Fatalf("unexpected secret: %v", read)
+	}
+
+	tests := map[string]struct {
+		hook          func(secretID string) (*sdk.SecretResponse, error)
+		expectSummary string
+		expectDetail  string
+	}{
+		"missing secret": {
+			hook: func(secretID string) (*sdk.SecretResponse, error) {
+				return nil, errors.New("API error: [404 Not Found] Resource not found")
+			},
+			expectSummary: "Unable to Read Secret with id: " + secret.ID,
+			expectDetail:  "does not exist",
+		},
+		"empty response": {
+			hook: func(secretID string) (*sdk.SecretResponse, error) {
+				return nil, nil
+			},
+			expectSummary: "Unexpected Bitwarden Secrets Manager Response",
+			expectDetail:  "empty secret",
+		},
+		"secret without project": {
+			hook: func(secretID string) (*sdk.SecretResponse, error) {
+				return &sdk.SecretResponse{ID: secretID}, nil
+			},
+			expectSummary: "Unexpected Bitwarden Secrets Manager Response",
+			expectDetail:  "without a project ID",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client.secretGetHook = test.hook
+			defer func() { client.secretGetHook = nil }()
+
+			_, err := readSecret(client, secret.ID)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+
+			var diags diag.Diagnostics
+			addReadSecretError(&diags, secret.ID, err, mockOrgId, false)
+			if len(diags) != 1 || diags[0].Summary() != test.expectSummary {
+				t.Fatalf("expected summary %q, got: %v", test.expectSummary, diags)
+			}
+			if !diagnosticsContain(diags, test.expectDetail) {
+				t.Fatalf("expected detail to contain %q, got: %v", test.expectDetail, diags)
+			}
+		})
+	}
+}
+
+func TestSecretMetadataSharedByResourceAndDataSource(t *testing.T) {
+	client := newMockBitwardenClient()
+	secret := client.addSecret("key", "value", "note", mockOrgId, validProjectUUID)
+
+	projectId, organizationId, creationDate, revisionDate := secretMetadata(&secret)
+	if projectId.ValueString() != validProjectUUID || organizationId.ValueString() != mockOrgId {
+		t.Fatalf("unexpected project or organization: %s, %s", projectId, organizationId)
+	}
+	if creationDate.ValueString() != secret.CreationDate.String() || revisionDate.ValueString() != secret.RevisionDate.String() {
+		t.Fatalf("unexpected timestamps: %s, %s", creationDate, revisionDate)
+	}
+
+	d := &secretDataSource{bitwardenClient: client, organizationId: mockOrgId}
+	dataSourceSchema := dataSourceTestSchema(t, d)
+	dataSourceResp := datasource.ReadResponse{State: tfsdk.State{Schema: dataSourceSchema}}
+	d.Read(context.Background(), datasource.ReadRequest{Config: newTestConfig(t, dataSourceSchema, secretDataSourceModel{ID: types.StringValue(secret.ID)})}, &dataSourceResp)
+	if dataSourceResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", dataSourceResp.Diagnostics)
+	}
+
+	r := &secretResource{bitwardenClient: client, organizationId: mockOrgId}
+	resourceSchema := secretResourceTestSchema(t)
+	state := newTestState(t, resourceSchema, secretResourceModel{ID: types.StringValue(secret.ID), Key: types.StringValue("key")})
+	resourceResp := fwresource.ReadResponse{State: state}
+	newTestPrivateState(&resourceResp.Private)
+	r.Read(context.Background(), fwresource.ReadRequest{State: state, Private: resourceResp.Private}, &resourceResp)
+	if resourceResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resourceResp.Diagnostics)
+	}
+
+	var dataSourceState secretDataSourceModel
+	var resourceState secretResourceModel
+	dataSourceResp.State.Get(context.Background(), &dataSourceState)
+	resourceResp.State.Get(context.Background(), &resourceState)
+	if !dataSourceState.ProjectID.Equal(resourceState.ProjectID) ||
+		!dataSourceState.OrganizationID.Equal(resourceState.OrganizationID) ||
+		!dataSourceState.CreationDate.Equal(resourceState.CreationDate) ||
+		!dataSourceState.RevisionDate.Equal(resourceState.RevisionDate) {
+		t.Fatalf("expected the resource and the data source to map the secret identically, got: %v and %v", dataSourceState, resourceState)
+	}
+}